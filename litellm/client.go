@@ -2,7 +2,7 @@ package litellm
 
 import (
 	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -19,18 +19,28 @@ type Client struct {
 	APIKey             string
 	httpClient         *http.Client
 	InsecureSkipVerify bool
+	AuthHeaderStyle    string
+	Headers            map[string]string
+	ValidateModelNames bool
+
+	// tokens, cache and limits are shared by the copies of the client bound to a context
+	tokens *tokenState
+	cache  *readCache
+	limits *requestLimits
+	ctx    context.Context
 }
 
 func NewClient(apiBase, apiKey string, insecureSkipVerify bool) *Client {
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
-	}
+	// Without CA bundle or client certificate files the transport cannot fail to build
+	httpClient, _ := newHTTPClient(defaultTransportConfig(insecureSkipVerify))
 
 	return &Client{
 		APIBase:            apiBase,
 		APIKey:             apiKey,
-		httpClient:         &http.Client{Transport: tr},
+		httpClient:         httpClient,
 		InsecureSkipVerify: insecureSkipVerify,
+		AuthHeaderStyle:    authHeaderStyleXAPIKey,
+		tokens:             &tokenState{token: apiKey},
 	}
 }
 
@@ -100,8 +110,10 @@ func (c *Client) DeleteOrganizationMember(data map[string]interface{}) (map[stri
 }
 
 // Key-related methods
-func (c *Client) CreateKey(key *Key) (*Key, error) {
-	resp, err := c.sendRequest("POST", "/key/generate", key)
+
+// CreateKey generates a key. The request holds only the attributes to set.
+func (c *Client) CreateKey(data map[string]interface{}) (*Key, error) {
+	resp, err := c.sendRequest("POST", "/key/generate", data)
 	if err != nil {
 		return nil, err
 	}
@@ -109,8 +121,9 @@ func (c *Client) CreateKey(key *Key) (*Key, error) {
 	return c.parseKeyResponse(resp)
 }
 
-func (c *Client) GetKey(keyID string) (*Key, error) {
-	resp, err := c.sendRequest("GET", fmt.Sprintf("/key/info?key=%s", keyID), nil)
+// CreateServiceAccountKey generates a key that is owned by a team instead of a user.
+func (c *Client) CreateServiceAccountKey(data map[string]interface{}) (*Key, error) {
+	resp, err := c.sendRequest("POST", "/key/service-account/generate", data)
 	if err != nil {
 		return nil, err
 	}
@@ -118,35 +131,23 @@ func (c *Client) GetKey(keyID string) (*Key, error) {
 	return c.parseKeyResponse(resp)
 }
 
-func (c *Client) UpdateKey(key *Key) (*Key, error) {
-	// Create a new map with only the fields that can be updated
-	updateData := map[string]interface{}{
-		"key":                   key.Key,
-		"max_budget":            key.MaxBudget,
-		"team_id":               key.TeamID,
-		"max_parallel_requests": key.MaxParallelRequests,
-		"metadata":              key.Metadata,
-		"tpm_limit":             key.TPMLimit,
-		"rpm_limit":             key.RPMLimit,
-		"budget_duration":       key.BudgetDuration,
-		"key_alias":             key.KeyAlias,
-		"aliases":               key.Aliases,
-		"permissions":           key.Permissions,
-		"model_max_budget":      key.ModelMaxBudget,
-		"model_rpm_limit":       key.ModelRPMLimit,
-		"model_tpm_limit":       key.ModelTPMLimit,
-		"blocked":               key.Blocked,
+func (c *Client) GetKey(keyID string) (*Key, error) {
+	if key, ok := c.cachedKey(keyID); ok {
+		return key, nil
 	}
 
-	// Only add array fields if they are non-empty
-	if len(key.Models) > 0 {
-		updateData["models"] = key.Models
-	}
-	if len(key.Guardrails) > 0 {
-		updateData["guardrails"] = key.Guardrails
+	resp, err := c.sendRequest("GET", fmt.Sprintf("/key/info?key=%s", keyID), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := c.sendRequest("POST", "/key/update", updateData)
+	return c.parseKeyResponse(resp)
+}
+
+// UpdateKey updates the key identified by the "key" field of data. Null values
+// clear an attribute, attributes missing from data are left unchanged.
+func (c *Client) UpdateKey(data map[string]interface{}) (*Key, error) {
+	resp, err := c.sendRequest("POST", "/key/update", data)
 	if err != nil {
 		return nil, err
 	}
@@ -167,6 +168,20 @@ func (c *Client) parseKeyResponse(resp map[string]interface{}) (*Key, error) {
 		return nil, fmt.Errorf("received nil response")
 	}
 
+	// /key/info nests the key details under info, next to the key itself
+	if info, ok := resp["info"].(map[string]interface{}); ok {
+		merged := make(map[string]interface{}, len(info)+len(resp))
+		for k, v := range info {
+			merged[k] = v
+		}
+		for k, v := range resp {
+			if k != "info" {
+				merged[k] = v
+			}
+		}
+		resp = merged
+	}
+
 	createdKey := &Key{}
 
 	for k, v := range resp {
@@ -236,6 +251,12 @@ func (c *Client) parseKeyResponse(resp map[string]interface{}) (*Key, error) {
 			if s, ok := v.(string); ok {
 				createdKey.Duration = s
 			}
+		case "expires":
+			if s, ok := v.(string); ok {
+				createdKey.Expires = normalizeKeyExpires(s)
+			}
+		case "object_permission":
+			createdKey.ObjectPermission = parseObjectPermission(v)
 		case "aliases":
 			if m, ok := v.(map[string]interface{}); ok {
 				createdKey.Aliases = m
@@ -300,10 +321,10 @@ func (c *Client) sendRequest(method, path string, body interface{}) (map[string]
 			return nil, fmt.Errorf("error marshaling request body: %v", err)
 		}
 		log.Printf("Making %s request to %s with body:\n%s", method, url, c.redactSensitiveData(string(jsonBody)))
-		req, err = http.NewRequest(method, url, bytes.NewBuffer(jsonBody))
+		req, err = http.NewRequestWithContext(c.context(), method, url, bytes.NewBuffer(jsonBody))
 	} else {
 		log.Printf("Making %s request to %s", method, url)
-		req, err = http.NewRequest(method, url, nil)
+		req, err = http.NewRequestWithContext(c.context(), method, url, nil)
 	}
 
 	if err != nil {
@@ -311,10 +332,9 @@ func (c *Client) sendRequest(method, path string, body interface{}) (map[string]
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", c.APIKey)
 	req.Header.Set("accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %v", err)
 	}
@@ -350,7 +370,10 @@ func (c *Client) redactSensitiveData(data string) string {
 		`"(api_key|key|token|password|secret|credential|auth)":\s*"[^"]*"`,
 		`"(model_api_key|aws_access_key_id|aws_secret_access_key|vertex_credentials)":\s*"[^"]*"`,
 		`"(x-api-key)":\s*"[^"]*"`,
-		`"(credential_values)":\s*\{[^}]*\}`,
+		`"(Authorization)":\s*"[^"]*"`,
+		`"([a-z_]*client_secret)":\s*"[^"]*"`,
+		`"(authentication_token|auth_value)":\s*"[^"]*"`,
+		`"(credential_values|static_headers)":\s*\{[^}]*\}`,
 	}
 
 	result := data
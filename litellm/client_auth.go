@@ -0,0 +1,181 @@
+package litellm
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+const (
+	authHeaderStyleXAPIKey = "x-api-key"
+	authHeaderStyleBearer  = "bearer"
+)
+
+// tokenSource fetches a fresh token used to authenticate with LiteLLM.
+type tokenSource func() (string, error)
+
+// commandTokenSource runs a shell command and uses its trimmed output as the token.
+func commandTokenSource(command string) tokenSource {
+	return func() (string, error) {
+		var stderr bytes.Buffer
+		cmd := exec.Command("sh", "-c", command)
+		cmd.Stderr = &stderr
+
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("error running token_command: %v: %s", err, strings.TrimSpace(stderr.String()))
+		}
+
+		token := strings.TrimSpace(string(out))
+		if token == "" {
+			return "", fmt.Errorf("token_command returned an empty token")
+		}
+		return token, nil
+	}
+}
+
+// fileTokenSource reads the token from a file, which is re-read on every refresh.
+func fileTokenSource(path string) tokenSource {
+	return func() (string, error) {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("error reading token_file: %v", err)
+		}
+
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", fmt.Errorf("token_file %s is empty", path)
+		}
+		return token, nil
+	}
+}
+
+// tokenState holds the token sent with requests and the source it is refreshed from.
+type tokenState struct {
+	mu     sync.Mutex
+	token  string
+	source tokenSource
+}
+
+// setTokenSource fetches the first token from source and refreshes it from there on 401.
+func (c *Client) setTokenSource(source tokenSource) error {
+	token, err := source()
+	if err != nil {
+		return err
+	}
+
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+	c.tokens.token = token
+	c.tokens.source = source
+	return nil
+}
+
+// currentToken returns the token sent with requests.
+func (c *Client) currentToken() string {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+	return c.tokens.token
+}
+
+// refreshable reports whether the token can be refreshed after a 401.
+func (c *Client) refreshable() bool {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+	return c.tokens.source != nil
+}
+
+// refreshToken fetches a new token from the token source. Concurrent callers
+// that saw the same stale token only trigger one refresh.
+func (c *Client) refreshToken(staleToken string) error {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+
+	if c.tokens.token != staleToken {
+		return nil
+	}
+
+	token, err := c.tokens.source()
+	if err != nil {
+		return err
+	}
+
+	c.tokens.token = token
+	log.Printf("[INFO] Refreshed LiteLLM API token")
+	return nil
+}
+
+// setRequestHeaders sets the custom and authentication headers of a request.
+func (c *Client) setRequestHeaders(req *http.Request, token string) {
+	for k, v := range c.Headers {
+		req.Header.Set(k, v)
+	}
+
+	if c.AuthHeaderStyle == authHeaderStyleBearer {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else {
+		req.Header.Set("x-api-key", token)
+	}
+}
+
+// doExternal sends a request to a URL outside of LiteLLM, such as a key secret
+// webhook. It shares the transport and the global limits of the client, but
+// does not carry the custom or authentication headers.
+func (c *Client) doExternal(req *http.Request) (*http.Response, error) {
+	release, err := c.limits.acquire(req.Context(), "")
+	if err != nil {
+		return nil, fmt.Errorf("waiting to send %s %s: %w", req.Method, req.URL.Redacted(), err)
+	}
+	defer release()
+
+	return c.httpClient.Do(req)
+}
+
+// do sends a request to LiteLLM within the concurrency and rate limits of the
+// client. When the token comes from token_command or token_file, a 401 response
+// refreshes the token and retries the request once.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	c.invalidateReadCache(req)
+
+	path := c.endpointPath(req)
+	release, err := c.limits.acquire(req.Context(), path)
+	if err != nil {
+		return nil, fmt.Errorf("waiting to send %s %s: %w", req.Method, path, err)
+	}
+	defer release()
+
+	token := c.currentToken()
+	c.setRequestHeaders(req, token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !c.refreshable() {
+		return resp, err
+	}
+
+	log.Printf("[INFO] LiteLLM returned 401 for %s %s, refreshing the token", req.Method, req.URL.Path)
+	if err := c.refreshToken(token); err != nil {
+		log.Printf("[WARN] Error refreshing the token: %s", err)
+		return resp, nil
+	}
+
+	retry := req.Clone(req.Context())
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	resp.Body.Close()
+
+	if err := c.limits.wait(req.Context(), path); err != nil {
+		return nil, fmt.Errorf("waiting to send %s %s: %w", req.Method, path, err)
+	}
+	c.setRequestHeaders(retry, c.currentToken())
+	return c.httpClient.Do(retry)
+}
@@ -0,0 +1,284 @@
+package litellm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const (
+	endpointTeamList = "/team/list"
+	keyListPageSize  = 100
+)
+
+// readCacheKind is a kind of object whose list the read cache holds.
+type readCacheKind string
+
+const (
+	readCacheModels readCacheKind = "models"
+	readCacheTeams  readCacheKind = "teams"
+	readCacheKeys   readCacheKind = "keys"
+)
+
+// readCachePaths maps the path of the endpoints writing an object to its kind.
+var readCachePaths = []struct {
+	path string
+	kind readCacheKind
+}{
+	{"/model/", readCacheModels},
+	{"/team/", readCacheTeams},
+	{"/key/", readCacheKeys},
+}
+
+// readCache holds the lists of models, teams and keys fetched once per provider
+// instance, so that refreshing many resources does not read each one separately.
+// It is shared by the copies of the client bound to a context.
+type readCache struct {
+	mu      sync.Mutex
+	entries map[readCacheKind]*readCacheEntry
+	// written records the kinds written since the provider was configured, which
+	// are read from the API from then on so that a write is never served stale
+	written map[readCacheKind]bool
+}
+
+type readCacheEntry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		entries: make(map[readCacheKind]*readCacheEntry),
+		written: make(map[readCacheKind]bool),
+	}
+}
+
+// get returns the list of a kind, loading it on first use. Concurrent callers
+// wait for the same load. It returns false when the kind has been written or the
+// load failed, in which case the caller reads the object from the API.
+func (c *readCache) get(kind readCacheKind, load func() (interface{}, error)) (interface{}, bool) {
+	c.mu.Lock()
+	if c.written[kind] {
+		c.mu.Unlock()
+		return nil, false
+	}
+
+	entry, ok := c.entries[kind]
+	if !ok {
+		entry = &readCacheEntry{done: make(chan struct{})}
+		c.entries[kind] = entry
+		c.mu.Unlock()
+
+		entry.value, entry.err = load()
+		close(entry.done)
+
+		if entry.err != nil {
+			log.Printf("[WARN] Error listing %s for the read cache, reading them one by one: %s", kind, entry.err)
+			c.mu.Lock()
+			if c.entries[kind] == entry {
+				delete(c.entries, kind)
+			}
+			c.mu.Unlock()
+		}
+	} else {
+		c.mu.Unlock()
+		<-entry.done
+	}
+
+	if entry.err != nil {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// invalidate drops the list of a kind and stops caching it.
+func (c *readCache) invalidate(kind readCacheKind) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, kind)
+	c.written[kind] = true
+}
+
+// invalidateReadCache drops the cached list of the kind a request writes. It is
+// called before the request is sent, so reads that start during the write are
+// not served from the cache either.
+func (c *Client) invalidateReadCache(req *http.Request) {
+	if c.cache == nil || req.Method == http.MethodGet {
+		return
+	}
+	for _, p := range readCachePaths {
+		if strings.Contains(req.URL.Path, p.path) {
+			c.cache.invalidate(p.kind)
+		}
+	}
+}
+
+// cachedModel returns a model from the cached /model/info response. It returns
+// false when the cache is disabled or does not hold the model.
+func (c *Client) cachedModel(modelID string) (*DeployedModel, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+
+	value, ok := c.cache.get(readCacheModels, func() (interface{}, error) {
+		resp, err := MakeRequest(c, "GET", endpointModelInfo, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if err := handleResponse(resp, "listing models"); err != nil {
+			return nil, err
+		}
+
+		var listResp ModelListResponse
+		if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+			return nil, fmt.Errorf("error decoding model list response: %w", err)
+		}
+
+		models := make(map[string]*DeployedModel, len(listResp.Data))
+		for i := range listResp.Data {
+			if id := listResp.Data[i].ModelInfo.ID; id != "" {
+				models[id] = &listResp.Data[i]
+			}
+		}
+		return models, nil
+	})
+	if !ok {
+		return nil, false
+	}
+
+	model, ok := value.(map[string]*DeployedModel)[modelID]
+	return model, ok
+}
+
+// cachedTeam returns a team from the cached /team/list response. It returns
+// false when the cache is disabled or does not hold the complete team.
+func (c *Client) cachedTeam(teamID string) (*TeamResponse, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+
+	value, ok := c.cache.get(readCacheTeams, func() (interface{}, error) {
+		resp, err := MakeRequest(c, "GET", endpointTeamList, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if err := handleResponse(resp, "listing teams"); err != nil {
+			return nil, err
+		}
+
+		var list []json.RawMessage
+		if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+			return nil, fmt.Errorf("error decoding team list response: %w", err)
+		}
+
+		teams := make(map[string]*TeamResponse, len(list))
+		for _, data := range list {
+			var fields map[string]json.RawMessage
+			var team TeamResponse
+			if err := json.Unmarshal(data, &fields); err != nil {
+				return nil, fmt.Errorf("error decoding team list response: %w", err)
+			}
+			if err := json.Unmarshal(data, &team); err != nil {
+				return nil, fmt.Errorf("error decoding team list response: %w", err)
+			}
+			// Incomplete teams are left out and read from /team/info instead
+			if teamListResponseComplete(fields) {
+				teams[team.TeamID] = &team
+			}
+		}
+		return teams, nil
+	})
+	if !ok {
+		return nil, false
+	}
+
+	team, ok := value.(map[string]*TeamResponse)[teamID]
+	if !ok {
+		return nil, false
+	}
+	// Reads modify the response, each one gets its own copy
+	copied := *team
+	return &copied, true
+}
+
+// cachedKey returns a key from the cached /key/list response. Keys are listed by
+// their hashed token, a key ID holding the secret is hashed to find it. It
+// returns false when the cache is disabled or does not hold the key.
+func (c *Client) cachedKey(keyID string) (*Key, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+
+	value, ok := c.cache.get(readCacheKeys, func() (interface{}, error) {
+		list, err := listAllKeys(c)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make(map[string]map[string]interface{}, len(list))
+		for _, key := range list {
+			if token, _ := key["token"].(string); token != "" {
+				keys[token] = key
+			}
+		}
+		return keys, nil
+	})
+	if !ok {
+		return nil, false
+	}
+
+	token := keyID
+	if strings.HasPrefix(keyID, "sk-") {
+		token = hashKeyToken(keyID)
+	}
+	listed, ok := value.(map[string]map[string]interface{})[token]
+	if !ok {
+		return nil, false
+	}
+
+	key, err := c.parseKeyResponse(listed)
+	if err != nil {
+		return nil, false
+	}
+	// Like /key/info, the key is the ID it was read with
+	key.Key = keyID
+	return key, true
+}
+
+// listAllKeys pages through /key/list and returns the full key objects.
+func listAllKeys(client *Client) ([]map[string]interface{}, error) {
+	var keys []map[string]interface{}
+	for page := 1; ; page++ {
+		endpoint := fmt.Sprintf("/key/list?return_full_object=true&include_team_keys=true&page=%d&size=%d", page, keyListPageSize)
+
+		resp, err := MakeRequest(client, "GET", endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		var listResp struct {
+			Keys       []map[string]interface{} `json:"keys"`
+			TotalPages int                      `json:"total_pages"`
+		}
+		err = handleResponse(resp, "listing keys")
+		if err == nil {
+			err = json.NewDecoder(resp.Body).Decode(&listResp)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, listResp.Keys...)
+		if page >= listResp.TotalPages || len(listResp.Keys) == 0 {
+			return keys, nil
+		}
+	}
+}
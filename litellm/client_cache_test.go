@@ -0,0 +1,128 @@
+package litellm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// testCacheServer serves /team/list and /key/list, counting the requests per path.
+func testCacheServer(t *testing.T) (*Client, map[string]*int32) {
+	t.Helper()
+
+	counts := map[string]*int32{}
+	for _, p := range []string{endpointTeamList, "/key/list", "/team/update"} {
+		counts[p] = new(int32)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if count, ok := counts[r.URL.Path]; ok {
+			atomic.AddInt32(count, 1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case endpointTeamList:
+			fmt.Fprint(w, `[
+				{"team_id": "complete", "team_alias": "a", "litellm_model_table": null, "object_permission": null, "team_member_budget_table": null},
+				{"team_id": "incomplete", "team_alias": "b"}
+			]`)
+		case "/key/list":
+			fmt.Fprintf(w, `{"keys": [{"token": %q, "key_alias": "ci"}], "total_pages": 1}`, hashKeyToken("sk-1234"))
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, "sk-admin", false)
+	client.cache = newReadCache()
+	return client, counts
+}
+
+func TestCachedTeam(t *testing.T) {
+	client, counts := testCacheServer(t)
+
+	team, ok := client.cachedTeam("complete")
+	if !ok || team.TeamAlias != "a" {
+		t.Fatalf("complete team not served from the cache: %#v", team)
+	}
+	// A team missing fields of /team/info is read individually
+	if _, ok := client.cachedTeam("incomplete"); ok {
+		t.Error("incomplete team served from the cache")
+	}
+	if _, ok := client.cachedTeam("unknown"); ok {
+		t.Error("unknown team served from the cache")
+	}
+	if n := atomic.LoadInt32(counts[endpointTeamList]); n != 1 {
+		t.Errorf("/team/list requested %d times, want 1", n)
+	}
+
+	// Reads get their own copy
+	team.TeamAlias = "changed"
+	if team, _ := client.cachedTeam("complete"); team.TeamAlias != "a" {
+		t.Errorf("cached team was modified: %#v", team)
+	}
+}
+
+func TestReadCacheInvalidatedByWrite(t *testing.T) {
+	client, counts := testCacheServer(t)
+
+	if _, ok := client.cachedTeam("complete"); !ok {
+		t.Fatal("team not served from the cache")
+	}
+
+	resp, err := MakeRequest(client, "POST", "/team/update", map[string]interface{}{"team_id": "complete"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	if _, ok := client.cachedTeam("complete"); ok {
+		t.Error("team served from the cache after a write")
+	}
+	// Other kinds stay cached
+	if _, ok := client.cachedKey("sk-1234"); !ok {
+		t.Error("key not served from the cache after a team write")
+	}
+	if n := atomic.LoadInt32(counts[endpointTeamList]); n != 1 {
+		t.Errorf("/team/list requested %d times, want 1", n)
+	}
+}
+
+func TestCachedKey(t *testing.T) {
+	client, counts := testCacheServer(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.cachedKey("sk-1234")
+		}()
+	}
+	wg.Wait()
+	if n := atomic.LoadInt32(counts["/key/list"]); n != 1 {
+		t.Errorf("/key/list requested %d times, want 1", n)
+	}
+
+	// Keys are found by their secret or hashed token, and keep the ID they were read with
+	for _, id := range []string{"sk-1234", hashKeyToken("sk-1234")} {
+		key, ok := client.cachedKey(id)
+		if !ok {
+			t.Fatalf("key %s not served from the cache", id)
+		}
+		if key.Key != id || key.KeyAlias != "ci" {
+			t.Errorf("key = %#v", key)
+		}
+	}
+}
+
+func TestReadCacheDisabled(t *testing.T) {
+	client := NewClient("http://litellm.invalid", "sk-admin", false)
+	if _, ok := client.cachedTeam("complete"); ok {
+		t.Error("team served without a cache")
+	}
+}
@@ -0,0 +1,54 @@
+package litellm
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
+// withContext returns a copy of the client whose requests are bound to ctx, so
+// that they are cancelled when the operation times out or Terraform is interrupted.
+func (c *Client) withContext(ctx context.Context) *Client {
+	bound := *c
+	bound.ctx = ctx
+	return &bound
+}
+
+// context returns the context requests of the client are bound to.
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// sleepWithContext waits for the given delay, returning early with an error when ctx is done.
+func sleepWithContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// defaultResourceTimeouts returns the timeouts of a resource, configurable through a timeouts block.
+func defaultResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultCreateTimeout),
+		Read:   schema.DefaultTimeout(defaultReadTimeout),
+		Update: schema.DefaultTimeout(defaultUpdateTimeout),
+		Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+	}
+}
@@ -0,0 +1,186 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// requestLimits bounds the requests the client sends to LiteLLM. Requests to an
+// endpoint with an override use the semaphore or pacer of that endpoint instead
+// of the global one. A nil semaphore or pacer does not limit requests.
+type requestLimits struct {
+	semaphore          chan struct{}
+	pacer              *requestPacer
+	endpointSemaphores []endpointSemaphore
+	endpointPacers     []endpointPacer
+}
+
+type endpointSemaphore struct {
+	pattern   string
+	semaphore chan struct{}
+}
+
+type endpointPacer struct {
+	pattern string
+	pacer   *requestPacer
+}
+
+// requestPacer is a token bucket holding a single token, which spaces requests
+// evenly at the configured rate. Each caller reserves the next free slot.
+type requestPacer struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// newRequestLimits builds the limits of the client. Zero limits are unlimited.
+// Overrides map endpoint patterns to their limit, see endpointMatches.
+func newRequestLimits(maxConcurrent int, perSecond float64, concurrentOverrides, perSecondOverrides map[string]string) (*requestLimits, error) {
+	limits := &requestLimits{
+		semaphore: newSemaphore(maxConcurrent),
+		pacer:     newRequestPacer(perSecond),
+	}
+
+	for _, pattern := range sortedEndpointPatterns(concurrentOverrides) {
+		n, err := strconv.Atoi(concurrentOverrides[pattern])
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("endpoint_max_concurrent_requests[%q] must be a non-negative integer, got %q", pattern, concurrentOverrides[pattern])
+		}
+		limits.endpointSemaphores = append(limits.endpointSemaphores, endpointSemaphore{pattern: pattern, semaphore: newSemaphore(n)})
+	}
+
+	for _, pattern := range sortedEndpointPatterns(perSecondOverrides) {
+		rate, err := strconv.ParseFloat(perSecondOverrides[pattern], 64)
+		if err != nil || rate < 0 {
+			return nil, fmt.Errorf("endpoint_requests_per_second[%q] must be a non-negative number, got %q", pattern, perSecondOverrides[pattern])
+		}
+		limits.endpointPacers = append(limits.endpointPacers, endpointPacer{pattern: pattern, pacer: newRequestPacer(rate)})
+	}
+
+	return limits, nil
+}
+
+func newSemaphore(n int) chan struct{} {
+	if n <= 0 {
+		return nil
+	}
+	return make(chan struct{}, n)
+}
+
+func newRequestPacer(perSecond float64) *requestPacer {
+	if perSecond <= 0 {
+		return nil
+	}
+	return &requestPacer{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// acquire waits for a free request slot and the next paced start time of the
+// endpoint at path. The returned function releases the slot.
+func (l *requestLimits) acquire(ctx context.Context, path string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	semaphore := l.semaphore
+	for _, e := range l.endpointSemaphores {
+		if endpointMatches(e.pattern, path) {
+			semaphore = e.semaphore
+			break
+		}
+	}
+
+	release := func() {}
+	if semaphore != nil {
+		select {
+		case semaphore <- struct{}{}:
+			release = func() { <-semaphore }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if err := l.wait(ctx, path); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// wait waits for the next paced start time of the endpoint at path.
+func (l *requestLimits) wait(ctx context.Context, path string) error {
+	if l == nil {
+		return nil
+	}
+
+	pacer := l.pacer
+	for _, e := range l.endpointPacers {
+		if endpointMatches(e.pattern, path) {
+			pacer = e.pacer
+			break
+		}
+	}
+	if pacer == nil {
+		return nil
+	}
+
+	pacer.mu.Lock()
+	now := time.Now()
+	start := pacer.next
+	if start.Before(now) {
+		start = now
+	}
+	pacer.next = start.Add(pacer.interval)
+	pacer.mu.Unlock()
+
+	if delay := time.Until(start); delay > 0 {
+		return sleepWithContext(ctx, delay)
+	}
+	return nil
+}
+
+// endpointMatches reports whether the request path, relative to api_base, matches
+// an endpoint pattern. A pattern ending in * matches every path starting with the
+// rest of it, other patterns match the path exactly.
+func endpointMatches(pattern, path string) bool {
+	if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+		return strings.HasPrefix(path, prefix)
+	}
+	return path == pattern
+}
+
+// endpointPath returns the path of a request URL relative to the path of api_base.
+func (c *Client) endpointPath(req *http.Request) string {
+	base, err := url.Parse(c.APIBase)
+	if err != nil {
+		return req.URL.Path
+	}
+	return "/" + strings.TrimLeft(strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(base.Path, "/")), "/")
+}
+
+// sortedEndpointPatterns orders patterns so that exact patterns come before
+// wildcards and longer wildcards before shorter ones, making the most specific
+// override win.
+func sortedEndpointPatterns(overrides map[string]string) []string {
+	patterns := make([]string, 0, len(overrides))
+	for pattern := range overrides {
+		patterns = append(patterns, pattern)
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		wi, wj := strings.HasSuffix(patterns[i], "*"), strings.HasSuffix(patterns[j], "*")
+		if wi != wj {
+			return wj
+		}
+		if len(patterns[i]) != len(patterns[j]) {
+			return len(patterns[i]) > len(patterns[j])
+		}
+		return patterns[i] < patterns[j]
+	})
+	return patterns
+}
@@ -0,0 +1,147 @@
+package litellm
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRequestLimitsInvalid(t *testing.T) {
+	if _, err := newRequestLimits(0, 0, map[string]string{"/model/new": "x"}, nil); err == nil {
+		t.Error("expected an error for a non-integer concurrency override")
+	}
+	if _, err := newRequestLimits(0, 0, nil, map[string]string{"/model/new": "-1"}); err == nil {
+		t.Error("expected an error for a negative rate override")
+	}
+}
+
+func TestEndpointMatches(t *testing.T) {
+	for _, tc := range []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"/model/new", "/model/new", true},
+		{"/model/new", "/model/new/x", false},
+		{"/model/*", "/model/update", true},
+		{"/model/*", "/team/new", false},
+		{"*", "/team/new", true},
+	} {
+		if got := endpointMatches(tc.pattern, tc.path); got != tc.want {
+			t.Errorf("endpointMatches(%q, %q) = %t, want %t", tc.pattern, tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestSortedEndpointPatterns(t *testing.T) {
+	got := sortedEndpointPatterns(map[string]string{"*": "", "/model/*": "", "/model/new": "", "/key/*": ""})
+	want := []string{"/model/new", "/model/*", "/key/*", "*"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestEndpointPath(t *testing.T) {
+	client := NewClient("https://proxy.example.com/litellm/", "sk-admin", false)
+	req, _ := http.NewRequest("GET", "https://proxy.example.com/litellm/model/info", nil)
+	if got := client.endpointPath(req); got != "/model/info" {
+		t.Errorf("got %q, want /model/info", got)
+	}
+}
+
+func TestRequestLimitsConcurrency(t *testing.T) {
+	limits, err := newRequestLimits(2, 0, map[string]string{"/model/new": "1", "/team/*": "0"}, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	maxInFlight := func(path string, n int) int32 {
+		var inFlight, max int32
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				release, err := limits.acquire(context.Background(), path)
+				if err != nil {
+					t.Errorf("err: %s", err)
+					return
+				}
+				defer release()
+
+				current := atomic.AddInt32(&inFlight, 1)
+				for {
+					seen := atomic.LoadInt32(&max)
+					if current <= seen || atomic.CompareAndSwapInt32(&max, seen, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&inFlight, -1)
+			}()
+		}
+		wg.Wait()
+		return max
+	}
+
+	if got := maxInFlight("/key/generate", 6); got > 2 {
+		t.Errorf("global limit: %d requests in flight, want at most 2", got)
+	}
+	if got := maxInFlight("/model/new", 4); got != 1 {
+		t.Errorf("endpoint limit: %d requests in flight, want 1", got)
+	}
+	if got := maxInFlight("/team/new", 4); got < 2 {
+		t.Errorf("unlimited endpoint: %d requests in flight, want them to run together", got)
+	}
+}
+
+func TestRequestLimitsRate(t *testing.T) {
+	limits, err := newRequestLimits(0, 20, nil, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		release, err := limits.acquire(context.Background(), "/model/info")
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		release()
+	}
+	// The first request starts at once, the next four are spaced by 50ms
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Errorf("5 requests at 20/s took %s, want at least 200ms", elapsed)
+	}
+}
+
+func TestRequestLimitsCancelled(t *testing.T) {
+	limits, err := newRequestLimits(1, 0, nil, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	release, err := limits.acquire(context.Background(), "/model/info")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := limits.acquire(ctx, "/model/info"); err == nil {
+		t.Error("expected waiting for a slot to stop with the context")
+	}
+}
+
+func TestRequestLimitsNil(t *testing.T) {
+	var limits *requestLimits
+	release, err := limits.acquire(context.Background(), "/model/info")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	release()
+}
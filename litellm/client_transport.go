@@ -0,0 +1,168 @@
+package litellm
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+const (
+	defaultDialTimeout         = "30s"
+	defaultTLSHandshakeTimeout = "10s"
+	defaultRequestTimeout      = "5m"
+)
+
+// TransportConfig holds the TLS, proxy and timeout settings of the HTTP client.
+type TransportConfig struct {
+	InsecureSkipVerify  bool
+	CACertFile          string
+	CACertPEM           string
+	ClientCert          string
+	ClientKey           string
+	HTTPProxy           string
+	NoProxy             string
+	DialTimeout         time.Duration
+	TLSHandshakeTimeout time.Duration
+	RequestTimeout      time.Duration
+}
+
+// defaultTransportConfig returns the transport settings used when the provider sets none.
+func defaultTransportConfig(insecureSkipVerify bool) TransportConfig {
+	dialTimeout, _ := time.ParseDuration(defaultDialTimeout)
+	tlsHandshakeTimeout, _ := time.ParseDuration(defaultTLSHandshakeTimeout)
+	requestTimeout, _ := time.ParseDuration(defaultRequestTimeout)
+
+	return TransportConfig{
+		InsecureSkipVerify:  insecureSkipVerify,
+		DialTimeout:         dialTimeout,
+		TLSHandshakeTimeout: tlsHandshakeTimeout,
+		RequestTimeout:      requestTimeout,
+	}
+}
+
+// newHTTPClient builds the HTTP client used to talk to LiteLLM.
+func newHTTPClient(config TransportConfig) (*http.Client, error) {
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy, err := newProxyFunc(config.HTTPProxy, config.NoProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	tr := &http.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout:   config.DialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   config.TLSHandshakeTimeout,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	return &http.Client{Transport: tr, Timeout: config.RequestTimeout}, nil
+}
+
+// newTLSConfig builds the TLS configuration with the custom CA bundle and client certificate.
+func newTLSConfig(config TransportConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+
+	caPEM := config.CACertPEM
+	if config.CACertFile != "" {
+		data, err := ioutil.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ca_cert_file: %v", err)
+		}
+		caPEM = string(data)
+	}
+
+	if caPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caPEM)) {
+			return nil, fmt.Errorf("no valid PEM certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return nil, fmt.Errorf("client_cert and client_key must be set together")
+		}
+
+		certPEM, err := readPEMOrFile(config.ClientCert, "client_cert")
+		if err != nil {
+			return nil, err
+		}
+		keyPEM, err := readPEMOrFile(config.ClientKey, "client_key")
+		if err != nil {
+			return nil, err
+		}
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEMOrFile returns the value when it is PEM encoded, otherwise it reads the file it points to.
+func readPEMOrFile(value, field string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	data, err := ioutil.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %v", field, err)
+	}
+	return data, nil
+}
+
+// newProxyFunc returns the proxy selection of the transport. Without an
+// explicit proxy the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables apply.
+func newProxyFunc(httpProxy, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	if httpProxy == "" {
+		if noProxy == "" {
+			return http.ProxyFromEnvironment, nil
+		}
+		env := httpproxy.FromEnvironment()
+		env.NoProxy = noProxy
+		return requestProxyFunc(env), nil
+	}
+
+	if _, err := url.Parse(httpProxy); err != nil {
+		return nil, fmt.Errorf("invalid http_proxy: %v", err)
+	}
+
+	return requestProxyFunc(&httpproxy.Config{
+		HTTPProxy:  httpProxy,
+		HTTPSProxy: httpProxy,
+		NoProxy:    noProxy,
+	}), nil
+}
+
+func requestProxyFunc(config *httpproxy.Config) func(*http.Request) (*url.URL, error) {
+	proxyFunc := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+}
@@ -0,0 +1,348 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const convertConfigUsage = `Usage: terraform-provider-litellm convert-config [options] config.yaml
+
+Converts the model_list of a LiteLLM proxy config.yaml into litellm_model
+resources. Settings of litellm_settings and router_settings that apply to
+individual deployments are copied to every model, os.environ/ references become
+Terraform variables and parameters without a dedicated argument are kept in
+additional_litellm_params.
+
+Options:
+`
+
+const proxyConfigEnvPrefix = "os.environ/"
+
+// proxyConfig is the part of a proxy config.yaml that describes models.
+type proxyConfig struct {
+	ModelList       []proxyConfigModel     `yaml:"model_list"`
+	RouterSettings  map[string]interface{} `yaml:"router_settings"`
+	LiteLLMSettings map[string]interface{} `yaml:"litellm_settings"`
+}
+
+type proxyConfigModel struct {
+	ModelName     string                 `yaml:"model_name"`
+	LiteLLMParams map[string]interface{} `yaml:"litellm_params"`
+	ModelInfo     map[string]interface{} `yaml:"model_info"`
+}
+
+// proxyConfigSettings are the proxy-wide settings that are also accepted in the
+// litellm_params of a deployment. Later entries take precedence.
+var proxyConfigSettings = []struct {
+	section string
+	setting string
+	param   string
+}{
+	{"litellm_settings", "drop_params", "drop_params"},
+	{"litellm_settings", "request_timeout", "timeout"},
+	{"litellm_settings", "num_retries", "num_retries"},
+	{"router_settings", "timeout", "timeout"},
+	{"router_settings", "stream_timeout", "stream_timeout"},
+	{"router_settings", "num_retries", "num_retries"},
+	{"router_settings", "max_retries", "max_retries"},
+}
+
+// proxyConfigParams maps the litellm_params with a litellm_model argument to
+// that argument. Secrets are always written as variables.
+var proxyConfigParams = map[string]string{
+	"model":                              "",
+	"custom_llm_provider":                "",
+	"thinking":                           "",
+	"tpm":                                "tpm",
+	"rpm":                                "rpm",
+	"api_base":                           "model_api_base",
+	"api_version":                        "api_version",
+	"aws_region_name":                    "aws_region_name",
+	"reasoning_effort":                   "reasoning_effort",
+	"merge_reasoning_content_in_choices": "merge_reasoning_content_in_choices",
+	"input_cost_per_token":               "input_cost_per_million_tokens",
+	"output_cost_per_token":              "output_cost_per_million_tokens",
+	"input_cost_per_pixel":               "input_cost_per_pixel",
+	"output_cost_per_pixel":              "output_cost_per_pixel",
+	"input_cost_per_second":              "input_cost_per_second",
+	"output_cost_per_second":             "output_cost_per_second",
+}
+
+// proxyConfigModelInfo are the model_info fields with a litellm_model argument.
+var proxyConfigModelInfo = map[string]bool{"id": true, "mode": true, "tier": true, "team_id": true}
+
+// ConvertConfig implements the convert-config subcommand.
+func ConvertConfig(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("convert-config", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), convertConfigUsage)
+		flags.PrintDefaults()
+	}
+
+	dir := flags.String("dir", ".", "Directory the .tf files are written to")
+	force := flags.Bool("force", false, "Overwrite existing files")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("expected the path of a config.yaml")
+	}
+
+	data, err := ioutil.ReadFile(flags.Arg(0))
+	if err != nil {
+		return fmt.Errorf("error reading %s: %v", flags.Arg(0), err)
+	}
+
+	files, warnings, err := ConvertProxyConfig(data)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+
+	return writeExportFiles(*dir, files, *force)
+}
+
+// ConvertProxyConfig converts a proxy config.yaml into the contents of models.tf
+// and, when variables are referenced, variables.tf. Settings that cannot be
+// expressed with litellm_model are returned as warnings.
+func ConvertProxyConfig(data []byte) (map[string][]byte, []string, error) {
+	var config proxyConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, nil, fmt.Errorf("error parsing config: %v", err)
+	}
+	if len(config.ModelList) == 0 {
+		return nil, nil, fmt.Errorf("the config has no model_list")
+	}
+
+	var warnings []string
+	defaults := map[string]interface{}{}
+	for _, s := range proxyConfigSettings {
+		section := config.LiteLLMSettings
+		if s.section == "router_settings" {
+			section = config.RouterSettings
+		}
+		if v, ok := section[s.setting]; ok {
+			defaults[s.param] = v
+		}
+	}
+	for _, section := range []struct {
+		name     string
+		settings map[string]interface{}
+	}{
+		{"litellm_settings", config.LiteLLMSettings},
+		{"router_settings", config.RouterSettings},
+	} {
+		for _, setting := range sortedInterfaceKeys(section.settings) {
+			if !proxyConfigSettingCopied(section.name, setting) {
+				warnings = append(warnings, fmt.Sprintf("%s.%s applies to the whole proxy and has no litellm_model equivalent", section.name, setting))
+			}
+		}
+	}
+
+	labels := map[string]bool{}
+	var resources []*exportedResource
+	for i, model := range config.ModelList {
+		if model.ModelName == "" {
+			return nil, nil, fmt.Errorf("model_list[%d] has no model_name", i)
+		}
+
+		params := make(map[string]interface{}, len(defaults)+len(model.LiteLLMParams))
+		for k, v := range defaults {
+			params[k] = v
+		}
+		for k, v := range model.LiteLLMParams {
+			params[k] = v
+		}
+
+		r, modelWarnings, err := convertProxyConfigModel(labels, model, params)
+		if err != nil {
+			return nil, nil, fmt.Errorf("model_list[%d] (%s): %v", i, model.ModelName, err)
+		}
+		resources = append(resources, r)
+		warnings = append(warnings, modelWarnings...)
+	}
+
+	files := map[string][]byte{"models.tf": renderExportedResources(resources)}
+	if variables := renderExportVariables(resources); variables != nil {
+		files["variables.tf"] = variables
+	}
+	return files, warnings, nil
+}
+
+// convertProxyConfigModel converts a model_list entry. The parameters are
+// normalized to their JSON form so that the mapping of imported models applies.
+func convertProxyConfigModel(labels map[string]bool, model proxyConfigModel, params map[string]interface{}) (*exportedResource, []string, error) {
+	var warnings []string
+
+	normalized, err := normalizeProxyConfigValue(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	params = normalized.(map[string]interface{})
+
+	modelPath, _ := params["model"].(string)
+	if modelPath == "" {
+		return nil, nil, fmt.Errorf("litellm_params.model is not set")
+	}
+	if provider, _ := params["custom_llm_provider"].(string); provider == "" && !strings.Contains(modelPath, "/") {
+		params["custom_llm_provider"] = "openai"
+		warnings = append(warnings, fmt.Sprintf("%s has no provider prefix, openai was assumed", modelPath))
+	}
+
+	r := &exportedResource{
+		resourceType: "litellm_model",
+		label:        exportLabel(labels, "litellm_model", model.ModelName),
+	}
+
+	// Environment references and secrets are replaced by variables before the
+	// remaining parameters are mapped to arguments
+	references := map[string]interface{}{}
+	for _, param := range sortedInterfaceKeys(params) {
+		value, _ := params[param].(string)
+		if env := strings.TrimPrefix(value, proxyConfigEnvPrefix); env != value {
+			references[param] = exportReference(r.addEnvVariable(env, param))
+			delete(params, param)
+		}
+	}
+
+	var body []exportAttribute
+	for _, secret := range exportModelSecrets {
+		if ref, ok := references[secret.param]; ok {
+			body = append(body, exportAttribute{name: secret.attribute, variable: string(ref.(exportReference))})
+			delete(references, secret.param)
+		} else if v, ok := params[secret.param]; ok && v != "" {
+			body = append(body, exportAttribute{name: secret.attribute, variable: r.addVariable(secret.attribute, "string")})
+		}
+		delete(params, secret.param)
+	}
+
+	additional := map[string]interface{}{}
+	for _, param := range sortedInterfaceKeys(references) {
+		if attribute := proxyConfigParams[param]; attribute != "" {
+			body = append(body, exportAttribute{name: attribute, variable: string(references[param].(exportReference))})
+		} else {
+			additional[param] = references[param]
+		}
+	}
+	for param, value := range params {
+		if _, ok := proxyConfigParams[param]; ok {
+			continue
+		}
+		encoded, err := proxyConfigAdditionalValue(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("litellm_params.%s: %v", param, err)
+		}
+		additional[param] = encoded
+	}
+
+	deployed := &DeployedModel{ModelName: model.ModelName, LiteLLMParams: params}
+	for _, field := range []struct {
+		key   string
+		value *string
+	}{
+		{"mode", &deployed.ModelInfo.Mode},
+		{"tier", &deployed.ModelInfo.Tier},
+		{"team_id", &deployed.ModelInfo.TeamID},
+	} {
+		if v, ok := model.ModelInfo[field.key]; ok {
+			*field.value = fmt.Sprint(v)
+		}
+	}
+	for _, key := range sortedInterfaceKeys(model.ModelInfo) {
+		if !proxyConfigModelInfo[key] {
+			warnings = append(warnings, fmt.Sprintf("model_info.%s of %s has no litellm_model equivalent", key, model.ModelName))
+		}
+	}
+
+	var state modelResourceModel
+	importModelState(&state, deployed)
+
+	r.body = exportModelBody(&state)
+	r.body.attributes = append(r.body.attributes, body...)
+	if len(additional) > 0 {
+		r.body.attributes = append(r.body.attributes, exportAttribute{name: "additional_litellm_params", value: additional})
+	}
+	return r, warnings, nil
+}
+
+// addEnvVariable declares the variable replacing an os.environ/ reference and
+// returns its name. References to the same environment variable share it.
+func (r *exportedResource) addEnvVariable(env, param string) string {
+	name := exportIdentifier(env)
+	if name == "" {
+		name = r.label + "_" + param
+	}
+
+	sensitive := false
+	for _, secret := range exportModelSecrets {
+		sensitive = sensitive || secret.param == param
+	}
+	for _, word := range []string{"key", "secret", "token", "password", "credential"} {
+		sensitive = sensitive || strings.Contains(strings.ToLower(param), word)
+	}
+
+	r.variables = append(r.variables, exportVariable{
+		name:        name,
+		typeName:    "string",
+		description: fmt.Sprintf("Value of the %s environment variable of the proxy", env),
+		sensitive:   sensitive,
+	})
+	return name
+}
+
+// proxyConfigAdditionalValue converts a parameter into an additional_litellm_params
+// value. Lists and maps are JSON encoded, which the provider decodes again.
+func proxyConfigAdditionalValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []interface{}, map[string]interface{}:
+		encoded, err := json.Marshal(v)
+		return string(encoded), err
+	case nil:
+		return "", nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// normalizeProxyConfigValue converts YAML values into their JSON form, so numbers
+// are float64 and maps have string keys.
+func normalizeProxyConfigValue(value interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	err = json.Unmarshal(encoded, &normalized)
+	return normalized, err
+}
+
+func proxyConfigSettingCopied(section, setting string) bool {
+	for _, s := range proxyConfigSettings {
+		if s.section == section && s.setting == setting {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedInterfaceKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
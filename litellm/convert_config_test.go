@@ -0,0 +1,106 @@
+package litellm
+
+import (
+	"strings"
+	"testing"
+)
+
+const testProxyConfig = `
+model_list:
+  - model_name: gpt-4o
+    litellm_params:
+      model: openai/gpt-4o
+      api_key: os.environ/OPENAI_API_KEY
+      api_base: os.environ/OPENAI_API_BASE
+      organization: os.environ/OPENAI_ORG
+      tpm: 1000
+    model_info:
+      mode: chat
+      max_tokens: 10
+  - model_name: claude
+    litellm_params:
+      model: bedrock/claude
+      aws_secret_access_key: literal-secret
+      api_key: os.environ/OPENAI_API_KEY
+router_settings:
+  timeout: 30
+litellm_settings:
+  cache: true
+`
+
+func TestConvertProxyConfig(t *testing.T) {
+	files, warnings, err := ConvertProxyConfig([]byte(testProxyConfig))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	models := string(files["models.tf"])
+	for _, want := range []string{
+		`resource "litellm_model" "gpt_4o" {`,
+		`custom_llm_provider = "openai"`,
+		`tpm                 = 1000`,
+		`mode                = "chat"`,
+		// os.environ/ references become variables, also in additional_litellm_params
+		`model_api_key       = var.openai_api_key`,
+		`model_api_base      = var.openai_api_base`,
+		`organization = var.openai_org`,
+		// router_settings that apply to deployments are copied to every model
+		`timeout      = "30"`,
+		// Literal secrets are never written to the configuration
+		`aws_secret_access_key = var.claude_aws_secret_access_key`,
+	} {
+		if !strings.Contains(models, want) {
+			t.Errorf("models.tf does not contain %q:\n%s", want, models)
+		}
+	}
+	if strings.Contains(models, "literal-secret") || strings.Contains(models, "os.environ") {
+		t.Errorf("models.tf contains a secret or environment reference:\n%s", models)
+	}
+
+	variables := string(files["variables.tf"])
+	// References to the same environment variable share one sensitive variable
+	if n := strings.Count(variables, `variable "openai_api_key"`); n != 1 {
+		t.Errorf("openai_api_key declared %d times:\n%s", n, variables)
+	}
+	if !strings.Contains(variables, "Value of the OPENAI_API_KEY environment variable of the proxy\"\n  type        = string\n  sensitive   = true") {
+		t.Errorf("openai_api_key is not sensitive:\n%s", variables)
+	}
+	if strings.Contains(variables, "Value of the OPENAI_ORG environment variable of the proxy\"\n  type        = string\n  sensitive") {
+		t.Errorf("openai_org is sensitive:\n%s", variables)
+	}
+
+	wantWarnings := []string{
+		"litellm_settings.cache applies to the whole proxy and has no litellm_model equivalent",
+		"model_info.max_tokens of gpt-4o has no litellm_model equivalent",
+	}
+	if strings.Join(warnings, "\n") != strings.Join(wantWarnings, "\n") {
+		t.Errorf("warnings = %q, want %q", warnings, wantWarnings)
+	}
+}
+
+func TestConvertProxyConfigErrors(t *testing.T) {
+	for name, config := range map[string]string{
+		"no model_list": "litellm_settings: {}",
+		"no model_name": "model_list:\n  - litellm_params:\n      model: openai/gpt-4o",
+		"no model":      "model_list:\n  - model_name: gpt-4o\n    litellm_params: {}",
+		"not yaml":      "model_list: [",
+	} {
+		if _, _, err := ConvertProxyConfig([]byte(config)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestConvertProxyConfigNoVariables(t *testing.T) {
+	files, warnings, err := ConvertProxyConfig([]byte("model_list:\n  - model_name: gpt-4o\n    litellm_params:\n      model: gpt-4o"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, ok := files["variables.tf"]; ok {
+		t.Error("variables.tf written without variables")
+	}
+	// A model without provider prefix is assumed to be served by OpenAI
+	if !strings.Contains(string(files["models.tf"]), `custom_llm_provider = "openai"`) || len(warnings) != 1 {
+		t.Errorf("models.tf = %s, warnings = %q", files["models.tf"], warnings)
+	}
+}
@@ -1,15 +1,17 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceLiteLLMCredential() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceLiteLLMCredentialRead,
+		ReadContext: dataSourceLiteLLMCredentialRead,
 
 		Schema: map[string]*schema.Schema{
 			"credential_name": {
@@ -33,8 +35,8 @@ func dataSourceLiteLLMCredential() *schema.Resource {
 	}
 }
 
-func dataSourceLiteLLMCredentialRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func dataSourceLiteLLMCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	credentialName := d.Get("credential_name").(string)
 	modelID := d.Get("model_id").(string)
 
@@ -46,21 +48,21 @@ func dataSourceLiteLLMCredentialRead(d *schema.ResourceData, m interface{}) erro
 
 	resp, err := MakeRequest(client, "GET", endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("failed to read credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read credential: %w", err))
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("credential '%s' not found", credentialName)
+		return diag.FromErr(fmt.Errorf("credential '%s' not found", credentialName))
 	}
 
 	var credentialResp CredentialResponse
 	err = handleCredentialAPIResponse(resp, &credentialResp, client)
 	if err != nil {
 		if err.Error() == "credential_not_found" {
-			return fmt.Errorf("credential '%s' not found", credentialName)
+			return diag.FromErr(fmt.Errorf("credential '%s' not found", credentialName))
 		}
-		return fmt.Errorf("failed to read credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read credential: %w", err))
 	}
 
 	// Set the data source ID to the credential name
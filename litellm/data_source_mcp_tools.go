@@ -0,0 +1,154 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointMCPToolsList       = "/mcp-rest/tools/list"
+	endpointMCPToolsForCurrent = "/v1/mcp/tools"
+)
+
+func dataSourceLiteLLMMCPTools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLiteLLMMCPToolsRead,
+
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the MCP server to list tools for. When omitted, all tools available to the provider's key are listed",
+			},
+			"tools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Tools exposed by the MCP server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the tool",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the tool",
+						},
+						"input_schema": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON schema of the tool input, JSON-encoded",
+						},
+						"server_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the MCP server the tool belongs to",
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the tools, in the same order as tools",
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMMCPToolsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+	serverID := d.Get("server_id").(string)
+
+	endpoint := endpointMCPToolsForCurrent
+	if serverID != "" {
+		endpoint = fmt.Sprintf("%s?server_id=%s", endpointMCPToolsList, url.QueryEscape(serverID))
+	}
+
+	resp, err := MakeRequest(client, "GET", endpoint, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list MCP tools: %w", err))
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read MCP tools response: %w", err))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return diag.FromErr(fmt.Errorf("failed to list MCP tools: %s - %s", resp.Status, client.redactSensitiveData(string(body))))
+	}
+
+	tools, err := decodeMCPToolsResponse(body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list MCP tools: %w", err))
+	}
+
+	toolList := make([]interface{}, 0, len(tools))
+	names := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		inputSchema := ""
+		if tool.InputSchema != nil {
+			if s, ok := tool.InputSchema.(string); ok {
+				inputSchema = s
+			} else if encoded, err := json.Marshal(tool.InputSchema); err == nil {
+				inputSchema = string(encoded)
+			}
+		}
+
+		serverName := ""
+		if tool.MCPInfo != nil {
+			serverName = tool.MCPInfo.ServerName
+		}
+
+		toolList = append(toolList, map[string]interface{}{
+			"name":         tool.Name,
+			"description":  tool.Description,
+			"input_schema": inputSchema,
+			"server_name":  serverName,
+		})
+		names = append(names, tool.Name)
+	}
+
+	log.Printf("[DEBUG] Found %d MCP tools for server %q", len(tools), serverID)
+
+	if serverID != "" {
+		d.SetId(serverID)
+	} else {
+		d.SetId("all")
+	}
+	d.Set("tools", toolList)
+	d.Set("names", names)
+
+	return nil
+}
+
+// decodeMCPToolsResponse decodes a tool listing, which is either wrapped in an
+// object with a tools field or returned as a plain list.
+func decodeMCPToolsResponse(body []byte) ([]MCPTool, error) {
+	var listResp MCPToolsListResponse
+	if err := json.Unmarshal(body, &listResp); err == nil {
+		if listResp.Error != nil {
+			return nil, fmt.Errorf("%v", listResp.Error)
+		}
+		return listResp.Tools, nil
+	}
+
+	var tools []MCPTool
+	if err := json.Unmarshal(body, &tools); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %v", err)
+	}
+	return tools, nil
+}
@@ -1,15 +1,17 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceLiteLLMVectorStore() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceLiteLLMVectorStoreRead,
+		ReadContext: dataSourceLiteLLMVectorStoreRead,
 
 		Schema: map[string]*schema.Schema{
 			"vector_store_id": {
@@ -63,8 +65,8 @@ func dataSourceLiteLLMVectorStore() *schema.Resource {
 	}
 }
 
-func dataSourceLiteLLMVectorStoreRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func dataSourceLiteLLMVectorStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	vectorStoreID := d.Get("vector_store_id").(string)
 
 	// Use the info endpoint to get vector store details
@@ -74,21 +76,21 @@ func dataSourceLiteLLMVectorStoreRead(d *schema.ResourceData, m interface{}) err
 
 	resp, err := MakeRequest(client, "POST", "/vector_store/info", infoRequest)
 	if err != nil {
-		return fmt.Errorf("failed to read vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read vector store: %w", err))
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("vector store '%s' not found", vectorStoreID)
+		return diag.FromErr(fmt.Errorf("vector store '%s' not found", vectorStoreID))
 	}
 
 	var vectorStoreResp VectorStoreResponse
 	err = handleVectorStoreAPIResponse(resp, &vectorStoreResp, client)
 	if err != nil {
 		if err.Error() == "vector_store_not_found" {
-			return fmt.Errorf("vector store '%s' not found", vectorStoreID)
+			return diag.FromErr(fmt.Errorf("vector store '%s' not found", vectorStoreID))
 		}
-		return fmt.Errorf("failed to read vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read vector store: %w", err))
 	}
 
 	// Set the data source ID to the vector store ID
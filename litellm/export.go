@@ -0,0 +1,406 @@
+package litellm
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const exportUsage = `Usage: terraform-provider-litellm export [options]
+
+Writes the resources of an existing LiteLLM proxy as Terraform configuration,
+together with import blocks (Terraform 1.5 or later) that adopt them into state.
+Secrets that the API does not return are replaced by variables.
+
+The proxy is reached with the same environment variables as the provider, such
+as LITELLM_API_BASE, LITELLM_API_KEY or LITELLM_TOKEN_COMMAND.
+
+Options:
+`
+
+// exportBody is the content of an exported resource or nested block.
+type exportBody struct {
+	attributes []exportAttribute
+	blocks     []exportBlock
+}
+
+// exportAttribute is an exported attribute. When variable is set the attribute
+// references var.<variable> instead of holding the value.
+type exportAttribute struct {
+	name     string
+	value    interface{}
+	variable string
+}
+
+type exportBlock struct {
+	name string
+	body exportBody
+}
+
+// exportReference is a map element referencing var.<name>.
+type exportReference string
+
+// exportVariable is an input variable declared for a secret or an environment variable.
+type exportVariable struct {
+	name        string
+	typeName    string
+	description string
+	sensitive   bool
+}
+
+// exportedResource is a resource read from the proxy together with its import ID.
+type exportedResource struct {
+	resourceType string
+	label        string
+	id           string
+	body         exportBody
+	variables    []exportVariable
+}
+
+// Export implements the export subcommand.
+func Export(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), exportUsage)
+		flags.PrintDefaults()
+	}
+
+	dir := flags.String("dir", ".", "Directory the .tf files are written to")
+	resources := flags.String("resources", strings.Join(exportTypeNames(), ","), "Comma-separated resource kinds to export")
+	apiBase := flags.String("api-base", "", "Base URL of the proxy, overrides LITELLM_API_BASE")
+	apiKey := flags.String("api-key", "", "API key of the proxy, overrides LITELLM_API_KEY")
+	insecure := flags.Bool("insecure-skip-verify", false, "Skip TLS certificate verification")
+	force := flags.Bool("force", false, "Overwrite existing files")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	selected, err := selectExportTypes(*resources)
+	if err != nil {
+		return err
+	}
+
+	client, err := exportClient(ctx, *apiBase, *apiKey, *insecure)
+	if err != nil {
+		return err
+	}
+
+	files := map[string][]*exportedResource{}
+	var order []string
+	labels := map[string]bool{}
+	for _, t := range selected {
+		exported, err := t.export(ctx, client, labels)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipping %s: %s\n", t.name, err)
+			continue
+		}
+		if len(exported) == 0 {
+			continue
+		}
+		files[t.name+".tf"] = exported
+		order = append(order, t.name+".tf")
+		fmt.Printf("Exported %d %s\n", len(exported), t.name)
+	}
+
+	if len(order) == 0 {
+		return fmt.Errorf("nothing to export")
+	}
+
+	contents := map[string][]byte{}
+	var all []*exportedResource
+	for _, name := range order {
+		contents[name] = renderExportedResources(files[name])
+		all = append(all, files[name]...)
+	}
+	contents["imports.tf"] = renderExportImports(all)
+	if variables := renderExportVariables(all); variables != nil {
+		contents["variables.tf"] = variables
+	}
+
+	return writeExportFiles(*dir, contents, *force)
+}
+
+// exportClient configures a client from the provider environment variables, so
+// that every provider setting, such as token_command or ca_cert_file, applies.
+func exportClient(ctx context.Context, apiBase, apiKey string, insecure bool) (*Client, error) {
+	raw := map[string]interface{}{}
+	if apiBase != "" {
+		raw["api_base"] = apiBase
+	}
+	if apiKey != "" {
+		raw["api_key"] = apiKey
+	}
+	if insecure {
+		raw["insecure_skip_verify"] = true
+	}
+
+	p := Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		var messages []string
+		for _, d := range diags {
+			messages = append(messages, d.Summary)
+		}
+		return nil, fmt.Errorf("error configuring the LiteLLM client: %s", strings.Join(messages, "; "))
+	}
+
+	return p.Meta().(*Client).withContext(ctx), nil
+}
+
+func selectExportTypes(names string) ([]exportType, error) {
+	wanted := map[string]bool{}
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			wanted[name] = true
+		}
+	}
+
+	var selected []exportType
+	for _, t := range exportTypes {
+		if wanted[t.name] {
+			selected = append(selected, t)
+			delete(wanted, t.name)
+		}
+	}
+	if len(wanted) > 0 {
+		return nil, fmt.Errorf("unknown resource kinds %s, expected some of %s", strings.Join(sortedKeys(wanted), ", "), strings.Join(exportTypeNames(), ", "))
+	}
+	return selected, nil
+}
+
+func exportTypeNames() []string {
+	names := make([]string, len(exportTypes))
+	for i, t := range exportTypes {
+		names[i] = t.name
+	}
+	return names
+}
+
+var exportLabelInvalid = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportIdentifier converts a name into a Terraform identifier, or returns "" when nothing of it is left.
+func exportIdentifier(name string) string {
+	identifier := strings.Trim(exportLabelInvalid.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if identifier != "" && identifier[0] >= '0' && identifier[0] <= '9' {
+		identifier = "r_" + identifier
+	}
+	return identifier
+}
+
+// exportLabel returns a unique resource label derived from the name of a resource.
+func exportLabel(labels map[string]bool, resourceType, name string) string {
+	label := exportIdentifier(name)
+	if label == "" {
+		label = strings.TrimPrefix(resourceType, "litellm_")
+	}
+
+	unique := label
+	for i := 2; labels[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	labels[resourceType+"."+unique] = true
+	return unique
+}
+
+func renderExportedResources(resources []*exportedResource) []byte {
+	f := hclwrite.NewEmptyFile()
+	for i, r := range resources {
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+		block := f.Body().AppendNewBlock("resource", []string{r.resourceType, r.label})
+		writeExportBody(block.Body(), r.body)
+	}
+	return f.Bytes()
+}
+
+func writeExportBody(body *hclwrite.Body, b exportBody) {
+	for _, a := range b.attributes {
+		if a.variable != "" {
+			body.SetAttributeTraversal(a.name, hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: a.variable},
+			})
+			continue
+		}
+		body.SetAttributeRaw(a.name, exportTokens(a.value))
+	}
+	for _, block := range b.blocks {
+		writeExportBody(body.AppendNewBlock(block.name, nil).Body(), block.body)
+	}
+}
+
+func renderExportImports(resources []*exportedResource) []byte {
+	f := hclwrite.NewEmptyFile()
+	for i, r := range resources {
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+		body := f.Body().AppendNewBlock("import", nil).Body()
+		body.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: r.resourceType},
+			hcl.TraverseAttr{Name: r.label},
+		})
+		body.SetAttributeValue("id", cty.StringVal(r.id))
+	}
+	return f.Bytes()
+}
+
+// renderExportVariables declares the variables referenced by the resources, or
+// returns nil when there are none. Variables shared by several resources are declared once.
+func renderExportVariables(resources []*exportedResource) []byte {
+	f := hclwrite.NewEmptyFile()
+	declared := map[string]bool{}
+	count := 0
+	for _, r := range resources {
+		for _, v := range r.variables {
+			if declared[v.name] {
+				continue
+			}
+			declared[v.name] = true
+			if count > 0 {
+				f.Body().AppendNewline()
+			}
+			count++
+
+			body := f.Body().AppendNewBlock("variable", []string{v.name}).Body()
+			body.SetAttributeValue("description", cty.StringVal(v.description))
+			if strings.HasPrefix(v.typeName, "map") {
+				body.SetAttributeRaw("type", hclwrite.TokensForFunctionCall("map", hclwrite.TokensForIdentifier("string")))
+			} else {
+				body.SetAttributeRaw("type", hclwrite.TokensForIdentifier(v.typeName))
+			}
+			if v.sensitive {
+				body.SetAttributeValue("sensitive", cty.True)
+			}
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	return f.Bytes()
+}
+
+// writeExportFiles writes the generated files, refusing to overwrite existing ones unless force is set.
+func writeExportFiles(dir string, contents map[string][]byte, force bool) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating %s: %v", dir, err)
+	}
+
+	names := make([]string, 0, len(contents))
+	for name := range contents {
+		names = append(names, name)
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil && !force {
+			return fmt.Errorf("%s already exists, use -force to overwrite it", filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, hclwrite.Format(contents[name]), 0644); err != nil {
+			return fmt.Errorf("error writing %s: %v", path, err)
+		}
+		fmt.Printf("Wrote %s\n", path)
+	}
+	return nil
+}
+
+// exportTokens returns the expression of an attribute value. Maps holding
+// variable references are written as object expressions.
+func exportTokens(value interface{}) hclwrite.Tokens {
+	switch v := value.(type) {
+	case exportReference:
+		return hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: "var"},
+			hcl.TraverseAttr{Name: string(v)},
+		})
+	case map[string]interface{}:
+		hasReference := false
+		for _, e := range v {
+			_, ok := e.(exportReference)
+			hasReference = hasReference || ok
+		}
+		if !hasReference {
+			break
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		attrs := make([]hclwrite.ObjectAttrTokens, len(keys))
+		for i, k := range keys {
+			name := hclwrite.TokensForValue(cty.StringVal(k))
+			if hclsyntax.ValidIdentifier(k) {
+				name = hclwrite.TokensForIdentifier(k)
+			}
+			attrs[i] = hclwrite.ObjectAttrTokens{Name: name, Value: exportTokens(v[k])}
+		}
+		return hclwrite.TokensForObject(attrs)
+	}
+	return hclwrite.TokensForValue(exportCtyValue(value))
+}
+
+// exportCtyValue converts a value read from the API or a ResourceData into a cty value.
+func exportCtyValue(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case nil:
+		return cty.NullVal(cty.DynamicPseudoType)
+	case string:
+		return cty.StringVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case int64:
+		return cty.NumberIntVal(v)
+	case float64:
+		return cty.NumberFloatVal(v)
+	case []string:
+		values := make([]interface{}, len(v))
+		for i, s := range v {
+			values[i] = s
+		}
+		return exportCtyValue(values)
+	case []interface{}:
+		if len(v) == 0 {
+			return cty.EmptyTupleVal
+		}
+		values := make([]cty.Value, len(v))
+		for i, e := range v {
+			values[i] = exportCtyValue(e)
+		}
+		return cty.TupleVal(values)
+	case map[string]string:
+		values := make(map[string]interface{}, len(v))
+		for k, s := range v {
+			values[k] = s
+		}
+		return exportCtyValue(values)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal
+		}
+		values := make(map[string]cty.Value, len(v))
+		for k, e := range v {
+			values[k] = exportCtyValue(e)
+		}
+		return cty.ObjectVal(values)
+	default:
+		return cty.StringVal(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,511 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// exportType is a kind of resource the export subcommand can write.
+type exportType struct {
+	name   string
+	export func(ctx context.Context, client *Client, labels map[string]bool) ([]*exportedResource, error)
+}
+
+// exportObject identifies a resource found in a list response.
+type exportObject struct {
+	resourceType string
+	id           string
+	name         string
+}
+
+var exportTypes = []exportType{
+	{name: "models", export: exportModels},
+	{name: "organizations", export: sdkExporter(listExportObjects("litellm_organization", "/organization/list", "", "organization_id", "organization_alias"))},
+	{name: "teams", export: sdkExporter(listExportObjects("litellm_team", endpointTeamList, "", "team_id", "team_alias"))},
+	{name: "keys", export: exportKeys},
+	{name: "credentials", export: sdkExporter(listExportObjects("litellm_credential", "/credentials", "credentials", "credential_name", "credential_name"))},
+	{name: "mcp_servers", export: sdkExporter(listExportObjects("litellm_mcp_server", endpointMCPServerRead, "", "server_id", "server_name"))},
+	{name: "vector_stores", export: sdkExporter(listExportObjects("litellm_vector_store", "/vector_store/list", "data", "vector_store_id", "vector_store_name"))},
+}
+
+// exportModelSecrets maps the litellm_params that hold secrets to their attribute.
+var exportModelSecrets = []struct {
+	param     string
+	attribute string
+}{
+	{"api_key", "model_api_key"},
+	{"aws_access_key_id", "aws_access_key_id"},
+	{"aws_secret_access_key", "aws_secret_access_key"},
+	{"aws_session_name", "aws_session_name"},
+	{"aws_role_name", "aws_role_name"},
+	{"vertex_project", "vertex_project"},
+	{"vertex_location", "vertex_location"},
+	{"vertex_credentials", "vertex_credentials"},
+}
+
+// exportModelDefaults are the schema defaults of litellm_model, which are left out.
+var exportModelDefaults = map[string]string{
+	"tier":                   "free",
+	"thinking_enabled":       "false",
+	"thinking_budget_tokens": "1024",
+}
+
+// exportModels exports the models stored in the database. Models defined in the
+// proxy config file cannot be managed through the API and are skipped.
+func exportModels(ctx context.Context, client *Client, labels map[string]bool) ([]*exportedResource, error) {
+	resp, err := MakeRequest(client, "GET", endpointModelInfo, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "listing models"); err != nil {
+		return nil, err
+	}
+
+	var listResp ModelListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		return nil, fmt.Errorf("error decoding model list response: %w", err)
+	}
+
+	var exported []*exportedResource
+	for i := range listResp.Data {
+		model := &listResp.Data[i]
+		if model.ModelInfo.ID == "" || !model.ModelInfo.DBModel {
+			log.Printf("[INFO] Skipping model %s, it is not stored in the database", model.ModelName)
+			continue
+		}
+
+		var state modelResourceModel
+		importModelState(&state, model)
+
+		r := &exportedResource{
+			resourceType: "litellm_model",
+			label:        exportLabel(labels, "litellm_model", model.ModelName),
+			id:           model.ModelInfo.ID,
+			body:         exportModelBody(&state),
+		}
+		for _, secret := range exportModelSecrets {
+			if v, ok := model.LiteLLMParams[secret.param].(string); ok && v != "" {
+				r.body.attributes = append(r.body.attributes, exportAttribute{name: secret.attribute, variable: r.addVariable(secret.attribute, "string")})
+			}
+		}
+		exported = append(exported, r)
+	}
+	return exported, nil
+}
+
+// exportModelBody returns the non-null attributes of a model state in schema order.
+func exportModelBody(state *modelResourceModel) exportBody {
+	var body exportBody
+
+	v := reflect.ValueOf(state).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Tag.Get("tfsdk")
+		value, ok := v.Field(i).Interface().(attr.Value)
+		if !ok || name == "id" || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		var native interface{}
+		switch value := value.(type) {
+		case types.String:
+			native = value.ValueString()
+		case types.Int64:
+			native = value.ValueInt64()
+		case types.Float64:
+			native = value.ValueFloat64()
+		case types.Bool:
+			native = value.ValueBool()
+		case types.Map:
+			elements := map[string]interface{}{}
+			for k, e := range value.Elements() {
+				if s, ok := e.(types.String); ok {
+					elements[k] = s.ValueString()
+				}
+			}
+			native = elements
+		default:
+			continue
+		}
+
+		if def, ok := exportModelDefaults[name]; ok && def == fmt.Sprint(native) {
+			continue
+		}
+		body.attributes = append(body.attributes, exportAttribute{name: name, value: native})
+	}
+	return body
+}
+
+// exportKeys exports the keys. The attributes match an import of the key.
+func exportKeys(ctx context.Context, client *Client, labels map[string]bool) ([]*exportedResource, error) {
+	objects, err := listKeyExportObjects(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var exported []*exportedResource
+	for _, object := range objects {
+		key, err := client.GetKey(object.id)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s %s: %s", object.resourceType, object.id, err)
+		}
+		if key == nil {
+			continue
+		}
+
+		res := &keyResource{serviceAccount: object.resourceType == "litellm_service_account_key"}
+		var state keyResourceModel
+		res.splitServiceAccountID(&state, key)
+		if diags := importKeyState(ctx, &state, key); diags.HasError() {
+			return nil, fmt.Errorf("error reading %s %s: %s", object.resourceType, object.id, diags[0].Summary())
+		}
+		value, diags := res.objectValue(ctx, &state)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error reading %s %s: %s", object.resourceType, object.id, diags[0].Summary())
+		}
+
+		s := keyResourceSchema(ctx, res.serviceAccount)
+		r := &exportedResource{
+			resourceType: object.resourceType,
+			label:        exportLabel(labels, object.resourceType, object.name),
+			id:           object.id,
+		}
+		r.body = r.exportFrameworkBody(s.Attributes, s.Blocks, value.Attributes(), "")
+		exported = append(exported, r)
+	}
+	return exported, nil
+}
+
+// sdkExporter exports SDK resources by reading each listed object with the Read
+// function of its resource, so that the exported attributes match an import.
+func sdkExporter(list func(client *Client) ([]exportObject, error)) func(context.Context, *Client, map[string]bool) ([]*exportedResource, error) {
+	return func(ctx context.Context, client *Client, labels map[string]bool) ([]*exportedResource, error) {
+		objects, err := list(client)
+		if err != nil {
+			return nil, err
+		}
+
+		resources := Provider().ResourcesMap
+		var exported []*exportedResource
+		for _, object := range objects {
+			res := resources[object.resourceType]
+
+			d := res.Data(nil)
+			d.SetId(object.id)
+			if diags := res.ReadContext(ctx, d, client); diags.HasError() {
+				return nil, fmt.Errorf("error reading %s %s: %s", object.resourceType, object.id, diags[0].Summary)
+			}
+			if d.Id() == "" {
+				continue
+			}
+
+			r := &exportedResource{
+				resourceType: object.resourceType,
+				label:        exportLabel(labels, object.resourceType, object.name),
+				id:           object.id,
+			}
+			values := make(map[string]interface{}, len(res.Schema))
+			for k := range res.Schema {
+				values[k] = d.Get(k)
+			}
+			r.body = r.exportSchemaBody(res.Schema, values, "")
+			exported = append(exported, r)
+		}
+		return exported, nil
+	}
+}
+
+// exportSchemaBody returns the configurable attributes and blocks of a resource.
+// Computed-only, deprecated, zero and default values are left out, and sensitive
+// values, which the API does not return, become variables.
+func (r *exportedResource) exportSchemaBody(s map[string]*schema.Schema, values map[string]interface{}, prefix string) exportBody {
+	var body exportBody
+
+	names := make([]string, 0, len(s))
+	for name, sc := range s {
+		if (sc.Optional || sc.Required) && sc.Deprecated == "" {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if s[names[i]].Required != s[names[j]].Required {
+			return s[names[i]].Required
+		}
+		return names[i] < names[j]
+	})
+
+	written := map[string]bool{}
+	for _, name := range names {
+		sc := s[name]
+		value := values[name]
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+
+		conflicting := false
+		for _, other := range sc.ConflictsWith {
+			conflicting = conflicting || written[other]
+		}
+		if conflicting {
+			continue
+		}
+
+		if sc.Sensitive {
+			if sc.Computed || (!sc.Required && exportZeroValue(value)) {
+				continue
+			}
+			typeName := "string"
+			if sc.Type == schema.TypeMap {
+				typeName = "map(string)"
+			}
+			body.attributes = append(body.attributes, exportAttribute{name: name, variable: r.addVariable(prefix+name, typeName)})
+			written[name] = true
+			continue
+		}
+
+		if exportZeroValue(value) || (sc.Default != nil && fmt.Sprint(sc.Default) == fmt.Sprint(value)) {
+			continue
+		}
+
+		if elem, ok := sc.Elem.(*schema.Resource); ok {
+			for _, item := range value.([]interface{}) {
+				if m, ok := item.(map[string]interface{}); ok {
+					body.blocks = append(body.blocks, exportBlock{name: name, body: r.exportSchemaBody(elem.Schema, m, prefix+name+"_")})
+				}
+			}
+		} else {
+			body.attributes = append(body.attributes, exportAttribute{name: name, value: value})
+		}
+		written[name] = true
+	}
+	return body
+}
+
+// exportFrameworkBody is the counterpart of exportSchemaBody for resources built
+// on terraform-plugin-framework. Null values are left out.
+func (r *exportedResource) exportFrameworkBody(attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block, values map[string]attr.Value, prefix string) exportBody {
+	var body exportBody
+
+	names := make([]string, 0, len(attributes))
+	for name, a := range attributes {
+		if (a.IsOptional() || a.IsRequired()) && a.GetDeprecationMessage() == "" {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if attributes[names[i]].IsRequired() != attributes[names[j]].IsRequired() {
+			return attributes[names[i]].IsRequired()
+		}
+		return names[i] < names[j]
+	})
+
+	for _, name := range names {
+		value := values[name]
+		if value == nil || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if attributes[name].IsSensitive() {
+			typeName := "string"
+			if _, ok := value.(types.Map); ok {
+				typeName = "map(string)"
+			}
+			body.attributes = append(body.attributes, exportAttribute{name: name, variable: r.addVariable(prefix+name, typeName)})
+			continue
+		}
+		body.attributes = append(body.attributes, exportAttribute{name: name, value: exportNativeValue(value)})
+	}
+
+	blockNames := make([]string, 0, len(blocks))
+	for name := range blocks {
+		blockNames = append(blockNames, name)
+	}
+	sort.Strings(blockNames)
+
+	for _, name := range blockNames {
+		var nested fwschema.NestedBlockObject
+		switch b := blocks[name].(type) {
+		case fwschema.ListNestedBlock:
+			nested = b.NestedObject
+		case fwschema.SetNestedBlock:
+			nested = b.NestedObject
+		default:
+			continue
+		}
+
+		var elements []attr.Value
+		switch v := values[name].(type) {
+		case types.List:
+			elements = v.Elements()
+		case types.Set:
+			elements = v.Elements()
+		}
+		for _, element := range elements {
+			if object, ok := element.(types.Object); ok && !object.IsNull() && !object.IsUnknown() {
+				body.blocks = append(body.blocks, exportBlock{
+					name: name,
+					body: r.exportFrameworkBody(nested.Attributes, nested.Blocks, object.Attributes(), prefix+name+"_"),
+				})
+			}
+		}
+	}
+	return body
+}
+
+// exportNativeValue converts a framework value into the value written to the configuration.
+func exportNativeValue(value attr.Value) interface{} {
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString()
+	case types.Int64:
+		return v.ValueInt64()
+	case types.Float64:
+		return v.ValueFloat64()
+	case types.Bool:
+		return v.ValueBool()
+	case types.List:
+		return exportNativeElements(v.Elements())
+	case types.Set:
+		return exportNativeElements(v.Elements())
+	case types.Map:
+		elements := make(map[string]interface{}, len(v.Elements()))
+		for k, e := range v.Elements() {
+			elements[k] = exportNativeValue(e)
+		}
+		return elements
+	}
+	return nil
+}
+
+func exportNativeElements(elements []attr.Value) []interface{} {
+	result := make([]interface{}, 0, len(elements))
+	for _, e := range elements {
+		result = append(result, exportNativeValue(e))
+	}
+	return result
+}
+
+// addVariable declares the variable holding a secret attribute and returns its name.
+func (r *exportedResource) addVariable(attribute, typeName string) string {
+	name := r.label + "_" + attribute
+	r.variables = append(r.variables, exportVariable{
+		name:        name,
+		typeName:    typeName,
+		description: fmt.Sprintf("%s of %s.%s", attribute, r.resourceType, r.label),
+		sensitive:   true,
+	})
+	return name
+}
+
+func exportZeroValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// listExportObjects returns a function listing the objects of an endpoint that
+// responds with a list, or with an object holding the list under field.
+func listExportObjects(resourceType, endpoint, field, idField, nameField string) func(client *Client) ([]exportObject, error) {
+	return func(client *Client) ([]exportObject, error) {
+		items, err := getExportList(client, endpoint, field)
+		if err != nil {
+			return nil, err
+		}
+
+		var objects []exportObject
+		for _, item := range items {
+			id, _ := item[idField].(string)
+			if id == "" {
+				continue
+			}
+			name, _ := item[nameField].(string)
+			if name == "" {
+				name = id
+			}
+			objects = append(objects, exportObject{resourceType: resourceType, id: id, name: name})
+		}
+		return objects, nil
+	}
+}
+
+// listKeyExportObjects lists the keys. Keys owned by a service account are
+// exported as litellm_service_account_key.
+func listKeyExportObjects(client *Client) ([]exportObject, error) {
+	keys, err := listAllKeys(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []exportObject
+	for _, key := range keys {
+		token, _ := key["token"].(string)
+		if token == "" {
+			continue
+		}
+		object := exportObject{resourceType: "litellm_key", id: token, name: token}
+		if alias, ok := key["key_alias"].(string); ok && alias != "" {
+			object.name = alias
+		}
+		if metadata, ok := key["metadata"].(map[string]interface{}); ok && metadata["service_account_id"] != nil {
+			object.resourceType = "litellm_service_account_key"
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+func getExportList(client *Client, endpoint, field string) ([]map[string]interface{}, error) {
+	resp, err := MakeRequest(client, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "listing "+endpoint); err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", endpoint, err)
+	}
+
+	list, ok := body.([]interface{})
+	if m, isMap := body.(map[string]interface{}); isMap && field != "" {
+		list, ok = m[field].([]interface{})
+	}
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s response", endpoint)
+	}
+
+	items := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			items = append(items, m)
+		}
+	}
+	return items, nil
+}
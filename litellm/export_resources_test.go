@@ -0,0 +1,111 @@
+package litellm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExportSchemaBody(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name":       {Type: schema.TypeString, Required: true},
+		"alias":      {Type: schema.TypeString, Optional: true},
+		"budget":     {Type: schema.TypeFloat, Optional: true},
+		"tier":       {Type: schema.TypeString, Optional: true, Default: "free"},
+		"api_key":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+		"unset_key":  {Type: schema.TypeString, Optional: true, Sensitive: true},
+		"spend":      {Type: schema.TypeFloat, Computed: true},
+		"old":        {Type: schema.TypeString, Optional: true, Deprecated: "use alias"},
+		"models":     {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"models_set": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}, ConflictsWith: []string{"models"}},
+		"limits": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+				"model":  {Type: schema.TypeString, Required: true},
+				"rpm":    {Type: schema.TypeInt, Optional: true},
+				"secret": {Type: schema.TypeString, Optional: true, Sensitive: true},
+			}},
+		},
+	}
+	values := map[string]interface{}{
+		"name":       "team",
+		"alias":      "",
+		"budget":     10.0,
+		"tier":       "free",
+		"api_key":    "sk-secret",
+		"unset_key":  "",
+		"spend":      1.5,
+		"old":        "legacy",
+		"models":     []interface{}{"gpt-4o"},
+		"models_set": []interface{}{"gpt-4o"},
+		"limits": []interface{}{
+			map[string]interface{}{"model": "gpt-4o", "rpm": 0, "secret": "s"},
+		},
+	}
+
+	r := &exportedResource{resourceType: "litellm_team", label: "team"}
+	got := r.exportSchemaBody(s, values, "")
+
+	want := exportBody{
+		attributes: []exportAttribute{
+			// Required attributes come first, the rest is sorted by name
+			{name: "name", value: "team"},
+			{name: "api_key", variable: "team_api_key"},
+			{name: "budget", value: 10.0},
+			{name: "models", value: []interface{}{"gpt-4o"}},
+		},
+		blocks: []exportBlock{{
+			name: "limits",
+			body: exportBody{attributes: []exportAttribute{
+				{name: "model", value: "gpt-4o"},
+				{name: "secret", variable: "team_limits_secret"},
+			}},
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	// Sensitive values become sensitive variables named after the resource
+	if len(r.variables) != 2 || r.variables[0].name != "team_api_key" || !r.variables[0].sensitive {
+		t.Errorf("variables = %#v", r.variables)
+	}
+}
+
+func TestExportFrameworkBody(t *testing.T) {
+	attributes := map[string]fwschema.Attribute{
+		"model_name": fwschema.StringAttribute{Required: true},
+		"tpm":        fwschema.Int64Attribute{Optional: true},
+		"rpm":        fwschema.Int64Attribute{Optional: true},
+		"api_key":    fwschema.StringAttribute{Optional: true, Sensitive: true},
+		"id":         fwschema.StringAttribute{Computed: true},
+		"tags":       fwschema.ListAttribute{Optional: true, ElementType: types.StringType},
+	}
+	values := map[string]attr.Value{
+		"model_name": types.StringValue("gpt-4o"),
+		"tpm":        types.Int64Value(0),
+		"rpm":        types.Int64Null(),
+		"api_key":    types.StringValue("sk-secret"),
+		"id":         types.StringValue("id"),
+		"tags":       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("prod")}),
+	}
+
+	r := &exportedResource{resourceType: "litellm_model", label: "gpt_4o"}
+	got := r.exportFrameworkBody(attributes, nil, values, "")
+
+	// Explicit zeros are exported, null values are not
+	want := exportBody{attributes: []exportAttribute{
+		{name: "model_name", value: "gpt-4o"},
+		{name: "api_key", variable: "gpt_4o_api_key"},
+		{name: "tags", value: []interface{}{"prod"}},
+		{name: "tpm", value: int64(0)},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ provider.Provider = &frameworkProvider{}
+
+// frameworkProvider serves the resources migrated to terraform-plugin-framework.
+// It is muxed with the SDK provider, whose schema it mirrors and whose Client it
+// shares, so both halves are configured by the same provider block.
+type frameworkProvider struct {
+	sdkProvider *sdkschema.Provider
+}
+
+// NewFrameworkProvider returns the framework half of the provider, backed by sdkProvider.
+func NewFrameworkProvider(sdkProvider *sdkschema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "litellm"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes := make(map[string]schema.Attribute, len(p.sdkProvider.Schema))
+
+	for name, s := range p.sdkProvider.Schema {
+		switch s.Type {
+		case sdkschema.TypeString:
+			attributes[name] = schema.StringAttribute{
+				Required:    s.Required,
+				Optional:    s.Optional,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case sdkschema.TypeBool:
+			attributes[name] = schema.BoolAttribute{
+				Required:    s.Required,
+				Optional:    s.Optional,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case sdkschema.TypeInt:
+			attributes[name] = schema.Int64Attribute{
+				Required:    s.Required,
+				Optional:    s.Optional,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case sdkschema.TypeFloat:
+			attributes[name] = schema.Float64Attribute{
+				Required:    s.Required,
+				Optional:    s.Optional,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case sdkschema.TypeMap:
+			attributes[name] = schema.MapAttribute{
+				Required:    s.Required,
+				Optional:    s.Optional,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+				ElementType: types.StringType,
+			}
+		default:
+			resp.Diagnostics.AddError(
+				"Unsupported provider attribute",
+				fmt.Sprintf("The provider attribute %q has a type that cannot be mirrored in the framework provider", name),
+			)
+		}
+	}
+
+	resp.Schema = schema.Schema{Attributes: attributes}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	// The mux server configures the SDK provider first, the Client it built is reused
+	client, ok := p.sdkProvider.Meta().(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The LiteLLM client has not been configured by the SDK provider",
+		)
+		return
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewModelResource,
+		NewKeyResource,
+		NewServiceAccountKeyResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
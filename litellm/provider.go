@@ -1,43 +1,143 @@
 package litellm
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"litellm_model":                   resourceLiteLLMModel(),
 			"litellm_team":                    ResourceLiteLLMTeam(),
 			"litellm_organization":            resourceLiteLLMOrganization(),
 			"litellm_organization_member":     resourceLiteLLMOrganizationMember(),
 			"litellm_organization_member_add": resourceLiteLLMOrganizationMemberAdd(),
 			"litellm_team_member":             resourceLiteLLMTeamMember(),
 			"litellm_team_member_add":         resourceLiteLLMTeamMemberAdd(),
-			"litellm_key":                     resourceKey(),
 			"litellm_mcp_server":              resourceLiteLLMMCPServer(),
+			"litellm_mcp_access_group":        resourceLiteLLMMCPAccessGroup(),
 			"litellm_credential":              resourceLiteLLMCredential(),
 			"litellm_vector_store":            resourceLiteLLMVectorStore(),
+			"litellm_sso_settings":            resourceLiteLLMSSOSettings(),
+			"litellm_ui_theme":                resourceLiteLLMUITheme(),
+			"litellm_internal_user_settings":  resourceLiteLLMInternalUserSettings(),
+			"litellm_default_team_settings":   resourceLiteLLMDefaultTeamSettings(),
+			"litellm_email_event_settings":    resourceLiteLLMEmailEventSettings(),
+			"litellm_scim_user":               resourceLiteLLMSCIMUser(),
+			"litellm_scim_group":              resourceLiteLLMSCIMGroup(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"litellm_credential":   dataSourceLiteLLMCredential(),
-			"litellm_vector_store": dataSourceLiteLLMVectorStore(),
+			"litellm_credential":       dataSourceLiteLLMCredential(),
+			"litellm_vector_store":     dataSourceLiteLLMVectorStore(),
+			"litellm_mcp_tools":        dataSourceLiteLLMMCPTools(),
+			"litellm_mcp_access_group": dataSourceLiteLLMMCPAccessGroup(),
 		},
 		Schema: map[string]*schema.Schema{
 			"api_base": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   false,
 				DefaultFunc: schema.EnvDefaultFunc("LITELLM_API_BASE", nil),
-				Description: "The base URL of the LiteLLM API",
+				Description: "The base URL of the LiteLLM API. Required, either in the provider block or through LITELLM_API_BASE",
 			},
 			"api_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("LITELLM_API_KEY", nil),
-				Description: "The API key for authenticating with LiteLLM",
+				Description: "The API key for authenticating with LiteLLM. Required unless token_command or token_file is set",
+			},
+			"auth_header_style": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LITELLM_AUTH_HEADER_STYLE", authHeaderStyleXAPIKey),
+				ValidateFunc: validation.StringInSlice([]string{authHeaderStyleXAPIKey, authHeaderStyleBearer}, false),
+				Description:  "How the API key or token is sent: x-api-key (default) or bearer (Authorization: Bearer)",
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional headers sent with every request, for example to route through an API gateway",
+			},
+			"token_command": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("LITELLM_TOKEN_COMMAND", nil),
+				ConflictsWith: []string{"token_file"},
+				Description:   "Shell command whose output is used as the token, for example to fetch a short-lived JWT. It is run again when the API returns 401",
+			},
+			"token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("LITELLM_TOKEN_FILE", nil),
+				ConflictsWith: []string{"token_command"},
+				Description:   "Path of a file containing the token. It is read again when the API returns 401",
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("LITELLM_CA_CERT_FILE", nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+				Description:   "Path of a PEM bundle of CA certificates trusted in addition to the system roots",
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "PEM bundle of CA certificates trusted in addition to the system roots",
+			},
+			"client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LITELLM_CLIENT_CERT", nil),
+				RequiredWith: []string{"client_key"},
+				Description:  "Client certificate for mutual TLS, as PEM or a path to a PEM file",
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("LITELLM_CLIENT_KEY", nil),
+				RequiredWith: []string{"client_cert"},
+				Description:  "Private key of the client certificate, as PEM or a path to a PEM file",
+			},
+			"http_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				Description:  "URL of the proxy used for requests to LiteLLM. Defaults to the HTTP_PROXY and HTTPS_PROXY environment variables",
+			},
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated hosts, domains and CIDRs that bypass the proxy. Defaults to the NO_PROXY environment variable",
+			},
+			"dial_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultDialTimeout,
+				ValidateFunc: validateDurationString,
+				Description:  "Timeout for establishing a connection to LiteLLM",
+			},
+			"tls_handshake_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultTLSHandshakeTimeout,
+				ValidateFunc: validateDurationString,
+				Description:  "Timeout for the TLS handshake with LiteLLM",
+			},
+			"request_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultRequestTimeout,
+				ValidateFunc: validateDurationString,
+				Description:  "Overall timeout of a request to LiteLLM, including reading the response",
 			},
 			"insecure_skip_verify": {
 				Type:        schema.TypeBool,
@@ -46,6 +146,44 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("LITELLM_INSECURE_SKIP_VERIFY", false),
 				Description: "Skip TLS certificate verification. Only use for development or when using self-signed certificates",
 			},
+			"validate_model_names": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LITELLM_VALIDATE_MODEL_NAMES", false),
+				Description: "Check at plan time that the models of keys, teams and organizations are deployed on the proxy",
+			},
+			"read_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LITELLM_READ_CACHE", false),
+				Description: "List models, teams and keys once per run and serve reads of individual resources from the lists",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LITELLM_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of requests sent to LiteLLM at the same time, 0 for no limit",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LITELLM_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Rate at which requests to LiteLLM are started, spaced evenly, 0 for no limit",
+			},
+			"endpoint_max_concurrent_requests": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Overrides of max_concurrent_requests by endpoint, such as /model/new, or endpoint prefix ending in *",
+			},
+			"endpoint_requests_per_second": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Overrides of requests_per_second by endpoint, such as /model/new, or endpoint prefix ending in *",
+			},
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -57,7 +195,88 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		APIBase:            d.Get("api_base").(string),
 		APIKey:             d.Get("api_key").(string),
 		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		AuthHeaderStyle:    d.Get("auth_header_style").(string),
+		Headers:            make(map[string]string),
+		TokenCommand:       d.Get("token_command").(string),
+		TokenFile:          d.Get("token_file").(string),
+		ValidateModelNames: d.Get("validate_model_names").(bool),
+		ReadCache:          d.Get("read_cache").(bool),
+	}
+	for k, v := range d.Get("headers").(map[string]interface{}) {
+		config.Headers[k] = v.(string)
+	}
+
+	config.Limits = RequestLimitsConfig{
+		MaxConcurrentRequests:         d.Get("max_concurrent_requests").(int),
+		RequestsPerSecond:             d.Get("requests_per_second").(float64),
+		EndpointMaxConcurrentRequests: make(map[string]string),
+		EndpointRequestsPerSecond:     make(map[string]string),
+	}
+	for k, v := range d.Get("endpoint_max_concurrent_requests").(map[string]interface{}) {
+		config.Limits.EndpointMaxConcurrentRequests[k] = v.(string)
+	}
+	for k, v := range d.Get("endpoint_requests_per_second").(map[string]interface{}) {
+		config.Limits.EndpointRequestsPerSecond[k] = v.(string)
+	}
+
+	// Timeouts have been validated by the schema
+	config.Transport = TransportConfig{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		CACertFile:         d.Get("ca_cert_file").(string),
+		CACertPEM:          d.Get("ca_cert_pem").(string),
+		ClientCert:         d.Get("client_cert").(string),
+		ClientKey:          d.Get("client_key").(string),
+		HTTPProxy:          d.Get("http_proxy").(string),
+		NoProxy:            d.Get("no_proxy").(string),
+	}
+	config.Transport.DialTimeout, _ = time.ParseDuration(d.Get("dial_timeout").(string))
+	config.Transport.TLSHandshakeTimeout, _ = time.ParseDuration(d.Get("tls_handshake_timeout").(string))
+	config.Transport.RequestTimeout, _ = time.ParseDuration(d.Get("request_timeout").(string))
+
+	// api_base is optional in the schema so that it is identical for every
+	// muxed server, whether or not LITELLM_API_BASE is set
+	if config.APIBase == "" {
+		return nil, fmt.Errorf("api_base must be set, either in the provider block or through LITELLM_API_BASE")
+	}
+
+	httpClient, err := newHTTPClient(config.Transport)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring the LiteLLM HTTP client: %w", err)
+	}
+
+	limits, err := newRequestLimits(config.Limits.MaxConcurrentRequests, config.Limits.RequestsPerSecond,
+		config.Limits.EndpointMaxConcurrentRequests, config.Limits.EndpointRequestsPerSecond)
+	if err != nil {
+		return nil, err
+	}
+
+	client := NewClient(config.APIBase, config.APIKey, config.InsecureSkipVerify)
+	client.limits = limits
+	client.httpClient = httpClient
+	client.Headers = config.Headers
+	client.ValidateModelNames = config.ValidateModelNames
+	if config.ReadCache {
+		client.cache = newReadCache()
+	}
+	if config.AuthHeaderStyle != "" {
+		client.AuthHeaderStyle = config.AuthHeaderStyle
+	}
+
+	// A token command or file takes precedence over the static API key
+	switch {
+	case config.TokenCommand != "":
+		if err := client.setTokenSource(commandTokenSource(config.TokenCommand)); err != nil {
+			return nil, fmt.Errorf("error fetching LiteLLM token: %w", err)
+		}
+	case config.TokenFile != "":
+		if err := client.setTokenSource(fileTokenSource(config.TokenFile)); err != nil {
+			return nil, fmt.Errorf("error fetching LiteLLM token: %w", err)
+		}
+	}
+
+	if client.currentToken() == "" {
+		return nil, fmt.Errorf("one of api_key, token_command or token_file must be set")
 	}
 
-	return NewClient(config.APIBase, config.APIKey, config.InsecureSkipVerify), nil
+	return client, nil
 }
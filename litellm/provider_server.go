@@ -0,0 +1,32 @@
+package litellm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// ProviderServer returns a protocol 6 server that combines the SDK provider with
+// the resources migrated to terraform-plugin-framework.
+func ProviderServer(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	sdkProvider := Provider()
+
+	upgradedSDKServer, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// The SDK server must come first: it is configured first and owns the Client
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer { return upgradedSDKServer },
+		providerserver.NewProtocol6(NewFrameworkProvider(sdkProvider)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
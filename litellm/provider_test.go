@@ -1,21 +1,25 @@
 package litellm
 
 import (
+	"context"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-var testAccProviders map[string]*schema.Provider
-var testAccProvider *schema.Provider
-
-func init() {
-	testAccProvider = Provider()
-	testAccProviders = map[string]*schema.Provider{
-		"litellm": testAccProvider,
-	}
+// testAccProtoV6ProviderFactories serve the muxed provider, so that acceptance
+// tests can use both SDK and framework resources.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"litellm": func() (tfprotov6.ProviderServer, error) {
+		providerServer, err := ProviderServer(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return providerServer(), nil
+	},
 }
 
 func TestProvider(t *testing.T) {
@@ -28,6 +32,28 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+// TestProviderServer checks that the SDK and framework providers can be muxed,
+// which requires identical provider schemas.
+func TestProviderServer(t *testing.T) {
+	providerServer, err := testAccProtoV6ProviderFactories["litellm"]()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := providerServer.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	for _, name := range []string{"litellm_model", "litellm_key", "litellm_service_account_key", "litellm_team"} {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("%s is not served", name)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("LITELLM_API_BASE"); v == "" {
 		t.Fatal("LITELLM_API_BASE must be set for acceptance tests")
@@ -6,10 +6,15 @@ import (
 
 func resourceLiteLLMCredential() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLiteLLMCredentialCreate,
-		Read:   resourceLiteLLMCredentialRead,
-		Update: resourceLiteLLMCredentialUpdate,
-		Delete: resourceLiteLLMCredentialDelete,
+		CreateContext: resourceLiteLLMCredentialCreate,
+		ReadContext:   resourceLiteLLMCredentialRead,
+		UpdateContext: resourceLiteLLMCredentialUpdate,
+		DeleteContext: resourceLiteLLMCredentialDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"credential_name": {
@@ -1,14 +1,16 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func resourceLiteLLMCredentialCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	credentialName := d.Get("credential_name").(string)
 	modelID := d.Get("model_id").(string)
@@ -36,23 +38,23 @@ func resourceLiteLLMCredentialCreate(d *schema.ResourceData, m interface{}) erro
 
 	resp, err := MakeRequest(client, "POST", "/credentials", credentialRequest)
 	if err != nil {
-		return fmt.Errorf("failed to create credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to create credential: %w", err))
 	}
 	defer resp.Body.Close()
 
 	err = handleCredentialAPIResponse(resp, nil, client)
 	if err != nil {
-		return fmt.Errorf("failed to create credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to create credential: %w", err))
 	}
 
 	// Set the resource ID to the credential name
 	d.SetId(credentialName)
 
-	return resourceLiteLLMCredentialRead(d, m)
+	return resourceLiteLLMCredentialRead(ctx, d, m)
 }
 
-func resourceLiteLLMCredentialRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	credentialName := d.Id()
 
 	// Try to get credential by name first
@@ -64,7 +66,7 @@ func resourceLiteLLMCredentialRead(d *schema.ResourceData, m interface{}) error
 
 	resp, err := MakeRequest(client, "GET", endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("failed to read credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read credential: %w", err))
 	}
 	defer resp.Body.Close()
 
@@ -80,7 +82,7 @@ func resourceLiteLLMCredentialRead(d *schema.ResourceData, m interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to read credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read credential: %w", err))
 	}
 
 	d.Set("credential_name", credentialResp.CredentialName)
@@ -91,8 +93,8 @@ func resourceLiteLLMCredentialRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceLiteLLMCredentialUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	credentialName := d.Id()
 
 	credentialInfo := d.Get("credential_info").(map[string]interface{})
@@ -119,26 +121,26 @@ func resourceLiteLLMCredentialUpdate(d *schema.ResourceData, m interface{}) erro
 	endpoint := fmt.Sprintf("/credentials/%s", credentialName)
 	resp, err := MakeRequest(client, "PATCH", endpoint, credentialRequest)
 	if err != nil {
-		return fmt.Errorf("failed to update credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update credential: %w", err))
 	}
 	defer resp.Body.Close()
 
 	err = handleCredentialAPIResponse(resp, nil, client)
 	if err != nil {
-		return fmt.Errorf("failed to update credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update credential: %w", err))
 	}
 
-	return resourceLiteLLMCredentialRead(d, m)
+	return resourceLiteLLMCredentialRead(ctx, d, m)
 }
 
-func resourceLiteLLMCredentialDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	credentialName := d.Id()
 
 	endpoint := fmt.Sprintf("/credentials/%s", credentialName)
 	resp, err := MakeRequest(client, "DELETE", endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("failed to delete credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to delete credential: %w", err))
 	}
 	defer resp.Body.Close()
 
@@ -148,7 +150,7 @@ func resourceLiteLLMCredentialDelete(d *schema.ResourceData, m interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to delete credential: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to delete credential: %w", err))
 	}
 
 	d.SetId("")
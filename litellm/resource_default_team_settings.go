@@ -0,0 +1,132 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointDefaultTeamSettingsGet    = "/get/default_team_settings"
+	endpointDefaultTeamSettingsUpdate = "/update/default_team_settings"
+
+	defaultTeamSettingsID = "default_team_settings"
+)
+
+var defaultTeamSettingsFields = []string{
+	"models",
+	"max_budget",
+	"budget_duration",
+	"tpm_limit",
+	"rpm_limit",
+}
+
+func resourceLiteLLMDefaultTeamSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiteLLMDefaultTeamSettingsCreate,
+		ReadContext:   resourceLiteLLMDefaultTeamSettingsRead,
+		UpdateContext: resourceLiteLLMDefaultTeamSettingsUpdate,
+		DeleteContext: resourceLiteLLMDefaultTeamSettingsDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			"models": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Default models for teams created through SSO",
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default maximum budget for teams created through SSO",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default budget reset period for teams created through SSO",
+			},
+			"tpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default tokens per minute limit for teams created through SSO",
+			},
+			"rpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default requests per minute limit for teams created through SSO",
+			},
+		},
+	}
+}
+
+func resourceLiteLLMDefaultTeamSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := writeDefaultTeamSettings(ctx, d, m); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating default team settings: %w", err))
+	}
+
+	d.SetId(defaultTeamSettingsID)
+	log.Printf("[INFO] Default team settings created")
+
+	return resourceLiteLLMDefaultTeamSettingsRead(ctx, d, m)
+}
+
+func resourceLiteLLMDefaultTeamSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	values, err := getSettings(client, endpointDefaultTeamSettingsGet)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading default team settings: %w", err))
+	}
+
+	return diag.FromErr(setSettingsFields(d, values, defaultTeamSettingsFields))
+}
+
+func resourceLiteLLMDefaultTeamSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := writeDefaultTeamSettings(ctx, d, m); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating default team settings: %w", err))
+	}
+
+	log.Printf("[INFO] Default team settings updated")
+	return resourceLiteLLMDefaultTeamSettingsRead(ctx, d, m)
+}
+
+func resourceLiteLLMDefaultTeamSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	payload := map[string]interface{}{
+		"models":          []string{},
+		"max_budget":      nil,
+		"budget_duration": nil,
+		"tpm_limit":       nil,
+		"rpm_limit":       nil,
+	}
+
+	if err := updateSettings(client, endpointDefaultTeamSettingsUpdate, payload); err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting default team settings: %w", err))
+	}
+
+	log.Printf("[INFO] Default team settings reset to defaults")
+	d.SetId("")
+	return nil
+}
+
+// writeDefaultTeamSettings performs a read-modify-write of the default team settings.
+func writeDefaultTeamSettings(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client).withContext(ctx)
+
+	current, err := getSettings(client, endpointDefaultTeamSettingsGet)
+	if err != nil {
+		return err
+	}
+
+	return updateSettings(client, endpointDefaultTeamSettingsUpdate, mergeSettings(d, current, defaultTeamSettingsFields))
+}
@@ -0,0 +1,196 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointEmailEventSettings      = "/email/event_settings"
+	endpointEmailEventSettingsReset = "/email/event_settings/reset"
+
+	emailEventSettingsID = "email_event_settings"
+)
+
+func resourceLiteLLMEmailEventSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiteLLMEmailEventSettingsCreate,
+		ReadContext:   resourceLiteLLMEmailEventSettingsRead,
+		UpdateContext: resourceLiteLLMEmailEventSettingsUpdate,
+		DeleteContext: resourceLiteLLMEmailEventSettingsDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			"events": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Description: "Map of email event names (e.g. \"Virtual Key Created\") to whether emails are sent for them",
+			},
+		},
+	}
+}
+
+func resourceLiteLLMEmailEventSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	if err := updateEmailEventSettings(client, d.Get("events").(map[string]interface{})); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating email event settings: %w", err))
+	}
+
+	d.SetId(emailEventSettingsID)
+	log.Printf("[INFO] Email event settings created")
+
+	return resourceLiteLLMEmailEventSettingsRead(ctx, d, m)
+}
+
+func resourceLiteLLMEmailEventSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	settings, err := readEmailEventSettings(client)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading email event settings: %w", err))
+	}
+
+	// Only track the events that are managed in the configuration, unless nothing is tracked yet
+	configured := d.Get("events").(map[string]interface{})
+	events := make(map[string]interface{})
+	for _, setting := range settings {
+		if _, ok := configured[setting.Event]; ok || len(configured) == 0 {
+			events[setting.Event] = setting.Enabled
+		}
+	}
+
+	d.Set("events", events)
+	return nil
+}
+
+func resourceLiteLLMEmailEventSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	oldEvents, newEvents := d.GetChange("events")
+	events := newEvents.(map[string]interface{})
+
+	// The proxy only resets all events at once: events removed from the
+	// configuration are reset and the other events are applied again
+	if removedEmailEvents(oldEvents.(map[string]interface{}), events) {
+		settings, err := readEmailEventSettings(client)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading email event settings: %w", err))
+		}
+		if err := resetEmailEventSettings(client); err != nil {
+			return diag.FromErr(fmt.Errorf("error resetting removed email events: %w", err))
+		}
+		events = emailEventsAfterReset(settings, oldEvents.(map[string]interface{}), events)
+	}
+
+	if err := updateEmailEventSettings(client, events); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating email event settings: %w", err))
+	}
+
+	log.Printf("[INFO] Email event settings updated")
+	return resourceLiteLLMEmailEventSettingsRead(ctx, d, m)
+}
+
+func resourceLiteLLMEmailEventSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	if err := resetEmailEventSettings(client); err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting email event settings: %w", err))
+	}
+
+	log.Printf("[INFO] Email event settings reset to defaults")
+	d.SetId("")
+	return nil
+}
+
+// updateEmailEventSettings sends the configured event map to the API.
+func updateEmailEventSettings(client *Client, events map[string]interface{}) error {
+	names := make([]string, 0, len(events))
+	for name := range events {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	req := EmailEventSettingsRequest{
+		Settings: make([]EmailEventSetting, 0, len(names)),
+	}
+	for _, name := range names {
+		req.Settings = append(req.Settings, EmailEventSetting{
+			Event:   name,
+			Enabled: events[name].(bool),
+		})
+	}
+
+	log.Printf("[DEBUG] Update email event settings request payload: %+v", req)
+
+	resp, err := MakeRequest(client, "PATCH", endpointEmailEventSettings, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return handleResponse(resp, "updating email event settings")
+}
+
+// readEmailEventSettings returns the email event settings of the proxy.
+func readEmailEventSettings(client *Client) ([]EmailEventSetting, error) {
+	resp, err := MakeRequest(client, "GET", endpointEmailEventSettings, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "reading email event settings"); err != nil {
+		return nil, err
+	}
+
+	var settingsResp EmailEventSettingsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&settingsResp); err != nil {
+		return nil, fmt.Errorf("error decoding email event settings response: %w", err)
+	}
+	return settingsResp.Settings, nil
+}
+
+// resetEmailEventSettings resets every email event to the proxy default.
+func resetEmailEventSettings(client *Client) error {
+	resp, err := MakeRequest(client, "POST", endpointEmailEventSettingsReset, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return handleResponse(resp, "resetting email event settings")
+}
+
+// removedEmailEvents reports whether an event of the prior configuration is no longer configured.
+func removedEmailEvents(oldEvents, newEvents map[string]interface{}) bool {
+	for name := range oldEvents {
+		if _, ok := newEvents[name]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// emailEventsAfterReset returns the events to apply after a reset: the configured
+// events, and the events not managed by Terraform with their current setting.
+// Events removed from the configuration are left out, so they keep their default.
+func emailEventsAfterReset(current []EmailEventSetting, oldEvents, newEvents map[string]interface{}) map[string]interface{} {
+	events := make(map[string]interface{}, len(current))
+	for _, setting := range current {
+		if _, ok := oldEvents[setting.Event]; !ok {
+			events[setting.Event] = setting.Enabled
+		}
+	}
+	for name, enabled := range newEvents {
+		events[name] = enabled
+	}
+	return events
+}
@@ -0,0 +1,88 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestEmailEventSettingsUpdateRemoved(t *testing.T) {
+	var requests []string
+	var patch EmailEventSettingsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PATCH":
+			json.NewDecoder(r.Body).Decode(&patch)
+			io.WriteString(w, `{}`)
+		case "POST":
+			io.WriteString(w, `{}`)
+		case "GET":
+			io.WriteString(w, `{"settings": [
+				{"event": "Virtual Key Created", "enabled": false},
+				{"event": "New User Invitation", "enabled": false},
+				{"event": "Budget Alert", "enabled": false}
+			]}`)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "sk-admin", false)
+
+	r := resourceLiteLLMEmailEventSettings()
+	state := &terraform.InstanceState{
+		ID: emailEventSettingsID,
+		Attributes: map[string]string{
+			"id":                         emailEventSettingsID,
+			"events.%":                   "2",
+			"events.Virtual Key Created": "false",
+			"events.New User Invitation": "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"events": map[string]interface{}{"New User Invitation": false},
+	})
+
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, state, config, client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, diags := r.Apply(ctx, state, diff, client); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	// The removed event is reset, the configured and unmanaged events are applied again
+	wantRequests := []string{
+		"GET " + endpointEmailEventSettings,
+		"POST " + endpointEmailEventSettingsReset,
+		"PATCH " + endpointEmailEventSettings,
+		"GET " + endpointEmailEventSettings,
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("requests = %v, want %v", requests, wantRequests)
+	}
+	want := []EmailEventSetting{
+		{Event: "Budget Alert", Enabled: false},
+		{Event: "New User Invitation", Enabled: false},
+	}
+	if !reflect.DeepEqual(patch.Settings, want) {
+		t.Errorf("settings = %#v, want %#v", patch.Settings, want)
+	}
+}
+
+func TestRemovedEmailEvents(t *testing.T) {
+	old := map[string]interface{}{"Virtual Key Created": false}
+	if removedEmailEvents(old, map[string]interface{}{"Virtual Key Created": true, "Budget Alert": false}) {
+		t.Error("changed and added events reported as removed")
+	}
+	if !removedEmailEvents(old, map[string]interface{}{}) {
+		t.Error("removed event not reported")
+	}
+}
@@ -0,0 +1,173 @@
+package litellm
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fieldConfigured reports whether an attribute is set in the configuration.
+// Unlike d.GetOk it also reports explicit zero, false and empty values.
+func fieldConfigured(d *schema.ResourceData, key string) bool {
+	return !rawConfigValue(d, key).IsNull()
+}
+
+// rawConfigValue returns an attribute as written in the configuration, or a
+// null value when the configuration is not available.
+func rawConfigValue(d *schema.ResourceData, key string) cty.Value {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return config.GetAttr(key)
+}
+
+// fieldRemoved reports whether an attribute of an existing resource was removed
+// from the configuration, i.e. it is not configured but set in the prior state.
+// The plan is not used: it keeps the prior value of Optional+Computed attributes
+// that are no longer configured.
+func fieldRemoved(d *schema.ResourceData, key string) bool {
+	if d.IsNewResource() || d.GetRawConfig().IsNull() || fieldConfigured(d, key) {
+		return false
+	}
+	prior, _ := d.GetChange(key)
+	return !fieldEmpty(prior)
+}
+
+// blockRemoved reports whether the blocks of a nested block type were removed
+// from the configuration. Unlike attributes, a block type without blocks is an
+// empty list in the configuration rather than null.
+func blockRemoved(d *schema.ResourceData, key string) bool {
+	if d.IsNewResource() {
+		return false
+	}
+	prior, _ := d.GetChange(key)
+	return !fieldEmpty(prior) && fieldEmpty(d.Get(key))
+}
+
+// fieldEmpty reports whether a value read from ResourceData is the zero value of its type.
+func fieldEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	}
+	return false
+}
+
+// fieldValue returns the value of an attribute as it is sent to the API.
+func fieldValue(d *schema.ResourceData, key string) interface{} {
+	v := d.Get(key)
+	if set, ok := v.(*schema.Set); ok {
+		return set.List()
+	}
+	return v
+}
+
+// putFields adds attributes to an API request. Configured values are sent even
+// when they are zero, false or empty. An attribute removed from the configuration
+// is cleared: lists and maps are sent empty and other values as null.
+func putFields(d *schema.ResourceData, data map[string]interface{}, keys ...string) {
+	for _, key := range keys {
+		switch {
+		case fieldConfigured(d, key):
+			data[key] = fieldValue(d, key)
+		case fieldRemoved(d, key):
+			// The value is not used: it is the prior value for computed attributes
+			switch fieldValue(d, key).(type) {
+			case []interface{}:
+				data[key] = []interface{}{}
+			case map[string]interface{}:
+				data[key] = map[string]interface{}{}
+			default:
+				data[key] = nil
+			}
+		}
+	}
+}
+
+// The put*Value functions are the counterparts of putFields for the resources
+// built on terraform-plugin-framework. A planned value is sent even when it is
+// zero or false, and a value that is null in the plan but set in the prior state
+// is cleared. Unknown values are not sent.
+
+func putStringValue(params map[string]interface{}, key string, value, prior types.String) {
+	switch {
+	case value.IsUnknown():
+	case !value.IsNull():
+		params[key] = value.ValueString()
+	case !prior.IsNull():
+		params[key] = nil
+	}
+}
+
+func putInt64Value(params map[string]interface{}, key string, value, prior types.Int64) {
+	switch {
+	case value.IsUnknown():
+	case !value.IsNull():
+		params[key] = value.ValueInt64()
+	case !prior.IsNull():
+		params[key] = nil
+	}
+}
+
+func putFloat64Value(params map[string]interface{}, key string, value, prior types.Float64, divisor float64) {
+	switch {
+	case value.IsUnknown():
+	case !value.IsNull():
+		params[key] = value.ValueFloat64() / divisor
+	case !prior.IsNull():
+		params[key] = nil
+	}
+}
+
+func putBoolValue(params map[string]interface{}, key string, value, prior types.Bool) {
+	switch {
+	case value.IsUnknown():
+	case !value.IsNull():
+		params[key] = value.ValueBool()
+	case !prior.IsNull():
+		params[key] = nil
+	}
+}
+
+// putListValue sends a list of strings. A removed list is sent empty.
+func putListValue(ctx context.Context, params map[string]interface{}, key string, value, prior types.List) {
+	switch {
+	case value.IsUnknown():
+	case !value.IsNull():
+		list := []string{}
+		value.ElementsAs(ctx, &list, false)
+		params[key] = list
+	case !prior.IsNull():
+		params[key] = []string{}
+	}
+}
+
+// putMapValue sends a map of strings. A removed map is sent empty.
+func putMapValue(ctx context.Context, params map[string]interface{}, key string, value, prior types.Map) {
+	switch {
+	case value.IsUnknown():
+	case !value.IsNull():
+		m := map[string]string{}
+		value.ElementsAs(ctx, &m, false)
+		params[key] = m
+	case !prior.IsNull():
+		params[key] = map[string]string{}
+	}
+}
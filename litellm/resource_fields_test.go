@@ -0,0 +1,215 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// applyPutFields applies a configuration to a resource built from putFields and
+// returns the request data putFields produced.
+func applyPutFields(t *testing.T, state *terraform.InstanceState, raw map[string]interface{}, config cty.Value) map[string]interface{} {
+	t.Helper()
+
+	var data map[string]interface{}
+	write := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		data = map[string]interface{}{}
+		putFields(d, data, "tpm_limit", "rpm_limit", "blocked", "models", "max_budget", "tags")
+		d.SetId("id")
+		return nil
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tpm_limit": {Type: schema.TypeInt, Optional: true},
+			"rpm_limit": {Type: schema.TypeInt, Optional: true},
+			"blocked":   {Type: schema.TypeBool, Optional: true},
+			"models":    {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			// Computed values are kept in the plan when they are removed from the configuration
+			"max_budget": {Type: schema.TypeFloat, Optional: true, Computed: true},
+			"tags":       {Type: schema.TypeList, Optional: true, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+		CreateContext: write,
+		UpdateContext: write,
+		ReadContext:   func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil },
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil },
+	}
+
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff == nil {
+		diff = &terraform.InstanceDiff{}
+	}
+	diff.RawConfig = config
+
+	if _, diags := r.Apply(ctx, state, diff, nil); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	return data
+}
+
+func testPutFieldsConfig(values map[string]cty.Value) cty.Value {
+	attributes := map[string]cty.Value{
+		"tpm_limit":  cty.NullVal(cty.Number),
+		"rpm_limit":  cty.NullVal(cty.Number),
+		"blocked":    cty.NullVal(cty.Bool),
+		"models":     cty.NullVal(cty.List(cty.String)),
+		"max_budget": cty.NullVal(cty.Number),
+		"tags":       cty.NullVal(cty.List(cty.String)),
+	}
+	for k, v := range values {
+		attributes[k] = v
+	}
+	return cty.ObjectVal(attributes)
+}
+
+func TestPutFieldsCreate(t *testing.T) {
+	data := applyPutFields(t, nil,
+		map[string]interface{}{"tpm_limit": 0, "blocked": false},
+		testPutFieldsConfig(map[string]cty.Value{
+			"tpm_limit": cty.NumberIntVal(0),
+			"blocked":   cty.False,
+		}))
+
+	// Explicit zero and false values are sent, omitted attributes are not
+	want := map[string]interface{}{"tpm_limit": 0, "blocked": false}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("got %#v, want %#v", data, want)
+	}
+}
+
+func TestPutFieldsUpdate(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "id",
+		Attributes: map[string]string{
+			"id":         "id",
+			"tpm_limit":  "100",
+			"rpm_limit":  "5",
+			"models.#":   "1",
+			"models.0":   "gpt-4o",
+			"max_budget": "10",
+			"tags.#":     "1",
+			"tags.0":     "prod",
+		},
+	}
+	data := applyPutFields(t, state,
+		map[string]interface{}{"rpm_limit": 5},
+		testPutFieldsConfig(map[string]cty.Value{
+			"rpm_limit": cty.NumberIntVal(5),
+		}))
+
+	// Removed attributes are cleared: scalars as null and lists as empty
+	want := map[string]interface{}{"tpm_limit": nil, "rpm_limit": 5, "models": []interface{}{}, "max_budget": nil, "tags": []interface{}{}}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("got %#v, want %#v", data, want)
+	}
+}
+
+func TestPutFieldsUpdateUnset(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "id",
+		Attributes: map[string]string{
+			"id":         "id",
+			"rpm_limit":  "5",
+			"max_budget": "0",
+			"blocked":    "false",
+		},
+	}
+	data := applyPutFields(t, state,
+		map[string]interface{}{"rpm_limit": 5},
+		testPutFieldsConfig(map[string]cty.Value{
+			"rpm_limit": cty.NumberIntVal(5),
+		}))
+
+	// Attributes that were never set are not sent
+	want := map[string]interface{}{"rpm_limit": 5}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("got %#v, want %#v", data, want)
+	}
+}
+
+func TestPutValues(t *testing.T) {
+	ctx := context.Background()
+	params := map[string]interface{}{}
+
+	putInt64Value(params, "zero", types.Int64Value(0), types.Int64Null())
+	putInt64Value(params, "removed", types.Int64Null(), types.Int64Value(100))
+	putInt64Value(params, "unset", types.Int64Null(), types.Int64Null())
+	putInt64Value(params, "unknown", types.Int64Unknown(), types.Int64Value(100))
+	putBoolValue(params, "false", types.BoolValue(false), types.BoolNull())
+	putFloat64Value(params, "cost", types.Float64Value(2), types.Float64Null(), 1000000.0)
+	putStringValue(params, "removed_string", types.StringNull(), types.StringValue("chat"))
+	putListValue(ctx, params, "removed_list", types.ListNull(types.StringType),
+		types.ListValueMust(types.StringType, []attr.Value{types.StringValue("gpt-4o")}))
+	putMapValue(ctx, params, "removed_map", types.MapNull(types.StringType),
+		types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")}))
+
+	want := map[string]interface{}{
+		"zero":           int64(0),
+		"removed":        nil,
+		"false":          false,
+		"cost":           2 / 1000000.0,
+		"removed_string": nil,
+		"removed_list":   []string{},
+		"removed_map":    map[string]string{},
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("got %#v, want %#v", params, want)
+	}
+}
+
+// testApplyRequest applies a JSON configuration to a resource with schema s and
+// the prior state, and returns the request body build produced during the apply.
+func testApplyRequest(t *testing.T, s map[string]*schema.Schema, state *terraform.InstanceState, config string, build func(d *schema.ResourceData) map[string]interface{}) map[string]interface{} {
+	t.Helper()
+
+	var data map[string]interface{}
+	write := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		data = build(d)
+		d.SetId("id")
+		return nil
+	}
+	r := &schema.Resource{
+		Schema:        s,
+		CreateContext: write,
+		UpdateContext: write,
+		ReadContext:   func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil },
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil },
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &raw); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	rawConfig, err := ctyjson.Unmarshal([]byte(config), r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff == nil {
+		diff = &terraform.InstanceDiff{}
+	}
+	diff.RawConfig = rawConfig
+
+	if _, diags := r.Apply(ctx, state, diff, nil); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	return data
+}
@@ -0,0 +1,156 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	endpointInternalUserSettingsGet    = "/get/internal_user_settings"
+	endpointInternalUserSettingsUpdate = "/update/internal_user_settings"
+
+	internalUserSettingsID = "internal_user_settings"
+)
+
+var internalUserSettingsFields = []string{
+	"user_role",
+	"max_budget",
+	"budget_duration",
+	"models",
+	"teams",
+}
+
+func resourceLiteLLMInternalUserSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiteLLMInternalUserSettingsCreate,
+		ReadContext:   resourceLiteLLMInternalUserSettingsRead,
+		UpdateContext: resourceLiteLLMInternalUserSettingsUpdate,
+		DeleteContext: resourceLiteLLMInternalUserSettingsDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			"user_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"proxy_admin",
+					"proxy_admin_viewer",
+					"internal_user",
+					"internal_user_viewer",
+				}, false),
+				Description: "Default role assigned to new internal users",
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default maximum budget for new internal users",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default budget reset period for new internal users",
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Default models new internal users can access",
+			},
+			"teams": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Team IDs new internal users are added to",
+			},
+		},
+	}
+}
+
+func resourceLiteLLMInternalUserSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := writeInternalUserSettings(ctx, d, m); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating internal user settings: %w", err))
+	}
+
+	d.SetId(internalUserSettingsID)
+	log.Printf("[INFO] Internal user settings created")
+
+	return resourceLiteLLMInternalUserSettingsRead(ctx, d, m)
+}
+
+func resourceLiteLLMInternalUserSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	values, err := getSettings(client, endpointInternalUserSettingsGet)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading internal user settings: %w", err))
+	}
+
+	// Teams may be returned as objects, only the team IDs are tracked
+	if teams, ok := values["teams"].([]interface{}); ok {
+		teamIDs := make([]interface{}, 0, len(teams))
+		for _, team := range teams {
+			switch t := team.(type) {
+			case string:
+				teamIDs = append(teamIDs, t)
+			case map[string]interface{}:
+				if teamID, ok := t["team_id"].(string); ok {
+					teamIDs = append(teamIDs, teamID)
+				}
+			}
+		}
+		values["teams"] = teamIDs
+	}
+
+	return diag.FromErr(setSettingsFields(d, values, internalUserSettingsFields))
+}
+
+func resourceLiteLLMInternalUserSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := writeInternalUserSettings(ctx, d, m); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating internal user settings: %w", err))
+	}
+
+	log.Printf("[INFO] Internal user settings updated")
+	return resourceLiteLLMInternalUserSettingsRead(ctx, d, m)
+}
+
+func resourceLiteLLMInternalUserSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	payload := map[string]interface{}{
+		"user_role":       "internal_user",
+		"max_budget":      nil,
+		"budget_duration": nil,
+		"models":          nil,
+		"teams":           nil,
+	}
+
+	if err := updateSettings(client, endpointInternalUserSettingsUpdate, payload); err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting internal user settings: %w", err))
+	}
+
+	log.Printf("[INFO] Internal user settings reset to defaults")
+	d.SetId("")
+	return nil
+}
+
+// writeInternalUserSettings performs a read-modify-write of the default internal user settings.
+func writeInternalUserSettings(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client).withContext(ctx)
+
+	current, err := getSettings(client, endpointInternalUserSettingsGet)
+	if err != nil {
+		return err
+	}
+
+	return updateSettings(client, endpointInternalUserSettingsUpdate, mergeSettings(d, current, internalUserSettingsFields))
+}
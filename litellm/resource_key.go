@@ -3,285 +3,567 @@ package litellm
 import (
 	"context"
 	"fmt"
+	"log"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-func resourceKey() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceKeyCreate,
-		ReadContext:   resourceKeyRead,
-		UpdateContext: resourceKeyUpdate,
-		DeleteContext: resourceKeyDelete,
-		Schema: map[string]*schema.Schema{
-			"key": {
-				Type:     schema.TypeString,
+var (
+	_ resource.Resource                   = &keyResource{}
+	_ resource.ResourceWithConfigure      = &keyResource{}
+	_ resource.ResourceWithImportState    = &keyResource{}
+	_ resource.ResourceWithUpgradeState   = &keyResource{}
+	_ resource.ResourceWithValidateConfig = &keyResource{}
+	_ resource.ResourceWithModifyPlan     = &keyResource{}
+)
+
+// keyResource manages litellm_key, and litellm_service_account_key when
+// serviceAccount is set, with terraform-plugin-framework.
+type keyResource struct {
+	client         *Client
+	serviceAccount bool
+}
+
+// keyResourceModel maps the litellm_key schema. litellm_service_account_key adds
+// service_account_id, which is read and written by get and set.
+type keyResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	Key                  types.String   `tfsdk:"key"`
+	Models               types.List     `tfsdk:"models"`
+	MaxBudget            types.Float64  `tfsdk:"max_budget"`
+	UserID               types.String   `tfsdk:"user_id"`
+	TeamID               types.String   `tfsdk:"team_id"`
+	MaxParallelRequests  types.Int64    `tfsdk:"max_parallel_requests"`
+	Metadata             types.Map      `tfsdk:"metadata"`
+	TPMLimit             types.Int64    `tfsdk:"tpm_limit"`
+	RPMLimit             types.Int64    `tfsdk:"rpm_limit"`
+	BudgetDuration       types.String   `tfsdk:"budget_duration"`
+	AllowedCacheControls types.List     `tfsdk:"allowed_cache_controls"`
+	SoftBudget           types.Float64  `tfsdk:"soft_budget"`
+	KeyAlias             types.String   `tfsdk:"key_alias"`
+	Duration             types.String   `tfsdk:"duration"`
+	Expires              types.String   `tfsdk:"expires"`
+	Aliases              types.Map      `tfsdk:"aliases"`
+	AliasesJSON          types.String   `tfsdk:"aliases_json"`
+	Config               types.Map      `tfsdk:"config"`
+	ConfigJSON           types.String   `tfsdk:"config_json"`
+	Permissions          types.Map      `tfsdk:"permissions"`
+	PermissionsJSON      types.String   `tfsdk:"permissions_json"`
+	ModelMaxBudget       types.Map      `tfsdk:"model_max_budget"`
+	ModelRPMLimit        types.Map      `tfsdk:"model_rpm_limit"`
+	ModelTPMLimit        types.Map      `tfsdk:"model_tpm_limit"`
+	ModelLimits          types.Set      `tfsdk:"model_limits"`
+	ObjectPermission     types.List     `tfsdk:"object_permission"`
+	Guardrails           types.List     `tfsdk:"guardrails"`
+	Blocked              types.Bool     `tfsdk:"blocked"`
+	Tags                 types.List     `tfsdk:"tags"`
+	Spend                types.Float64  `tfsdk:"spend"`
+	PGPKey               types.String   `tfsdk:"pgp_key"`
+	AgeRecipient         types.String   `tfsdk:"age_recipient"`
+	EncryptedKey         types.String   `tfsdk:"encrypted_key"`
+	SecretSink           types.List     `tfsdk:"secret_sink"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	ServiceAccountID     types.String   `tfsdk:"-"`
+}
+
+// NewKeyResource returns the litellm_key resource.
+func NewKeyResource() resource.Resource {
+	return &keyResource{}
+}
+
+func (r *keyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	if r.serviceAccount {
+		resp.TypeName = req.ProviderTypeName + "_service_account_key"
+		return
+	}
+	resp.TypeName = req.ProviderTypeName + "_key"
+}
+
+func (r *keyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = keyResourceSchema(ctx, r.serviceAccount)
+	resp.Schema.Version = 1
+}
+
+// keyResourceSchema returns the attributes of litellm_key, or of
+// litellm_service_account_key. They are unchanged from the SDK implementation
+// (schema version 0), only their null handling differs.
+func keyResourceSchema(ctx context.Context, serviceAccount bool) schema.Schema {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"models": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
-			"max_budget": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+			"key": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"user_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+			"models": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
 			},
-			"team_id": {
-				Type:     schema.TypeString,
+			"max_budget": schema.Float64Attribute{
 				Optional: true,
 			},
-			"max_parallel_requests": {
-				Type:     schema.TypeInt,
+			"user_id": schema.StringAttribute{
 				Optional: true,
-				Computed: true,
 			},
-			"metadata": {
-				Type:     schema.TypeMap,
+			"team_id": schema.StringAttribute{
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tpm_limit": {
-				Type:     schema.TypeInt,
+			"max_parallel_requests": schema.Int64Attribute{
 				Optional: true,
-				Computed: true,
 			},
-			"rpm_limit": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
 			},
-			"budget_duration": {
-				Type:     schema.TypeString,
+			"tpm_limit": schema.Int64Attribute{
 				Optional: true,
 			},
-			"allowed_cache_controls": {
-				Type:     schema.TypeList,
+			"rpm_limit": schema.Int64Attribute{
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"soft_budget": {
-				Type:     schema.TypeFloat,
+			"budget_duration": schema.StringAttribute{
 				Optional: true,
-				Computed: true,
 			},
-			"key_alias": {
-				Type:     schema.TypeString,
-				Optional: true,
+			"allowed_cache_controls": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
 			},
-			"duration": {
-				Type:     schema.TypeString,
+			"soft_budget": schema.Float64Attribute{
 				Optional: true,
 			},
-			"aliases": {
-				Type:     schema.TypeMap,
+			"key_alias": schema.StringAttribute{
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"config": {
-				Type:     schema.TypeMap,
+			"duration": schema.StringAttribute{
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Validators: []validator.String{
+					keyDurationValidator(),
+				},
 			},
-			"permissions": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			"expires": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp at which the key expires, empty if the key does not expire",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"model_max_budget": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeFloat, Computed: true},
+			"aliases":          keyMapAttribute("aliases"),
+			"aliases_json":     keyJSONAttribute("aliases"),
+			"config":           keyMapAttribute("config"),
+			"config_json":      keyJSONAttribute("config"),
+			"permissions":      keyMapAttribute("permissions"),
+			"permissions_json": keyJSONAttribute("permissions"),
+			"model_max_budget": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.Float64Type,
 			},
-			"model_rpm_limit": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeInt, Computed: true},
+			"model_rpm_limit": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
 			},
-			"model_tpm_limit": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeInt, Computed: true},
+			"model_tpm_limit": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
 			},
-			"guardrails": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			"guardrails": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
 			},
-			"blocked": {
-				Type:     schema.TypeBool,
+			"blocked": schema.BoolAttribute{
 				Optional: true,
 			},
-			"tags": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
 			},
-			"spend": {
-				Type:     schema.TypeFloat,
+			"spend": schema.Float64Attribute{
 				Computed: true,
 			},
+			"pgp_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "PGP public key, ASCII-armored or base64-encoded, used to encrypt the generated key into encrypted_key. The cleartext key is then not stored in state",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("age_recipient")),
+					pgpKeyValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"age_recipient": schema.StringAttribute{
+				Optional:    true,
+				Description: "age recipient (age1...) used to encrypt the generated key into encrypted_key. The cleartext key is then not stored in state",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("pgp_key")),
+					ageRecipientValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encrypted_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The generated key encrypted with pgp_key (base64-encoded) or age_recipient (ASCII-armored)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"model_limits":      modelLimitsBlock(),
+			"object_permission": objectPermissionBlock(),
+			"secret_sink": schema.ListNestedBlock{
+				Description: "Deliver the generated key to a local file or a webhook on create. The cleartext key is then not stored in state",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"file_path": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path of a local file the key is written to, with mode 0600",
+						},
+						"webhook_url": schema.StringAttribute{
+							Optional:    true,
+							Description: "URL the key is posted to as JSON",
+						},
+						"webhook_headers": schema.MapAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							ElementType: types.StringType,
+							Description: "Headers sent with the webhook request, e.g. for authentication",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
-}
 
-func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*Client)
+	if serviceAccount {
+		serviceAccountKeySchema(&s)
+	}
+	return s
+}
 
-	key := &Key{}
-	mapResourceDataToKey(d, key)
+func (r *keyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
 
-	createdKey, err := c.CreateKey(key)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating key: %s", err))
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *Client, got %T", req.ProviderData),
+		)
+		return
 	}
+	r.client = client
+}
 
-	d.SetId(createdKey.Key)
-	return resourceKeyRead(ctx, d, m)
+func (r *keyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func resourceKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*Client)
+// keyData is the configuration, plan or state of a key.
+type keyData interface {
+	Get(ctx context.Context, target interface{}) diag.Diagnostics
+}
 
-	key, err := c.GetKey(d.Id())
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading key: %s", err))
+// get reads a key from its configuration, plan or state.
+func (r *keyResource) get(ctx context.Context, data keyData, model *keyResourceModel) diag.Diagnostics {
+	if !r.serviceAccount {
+		return data.Get(ctx, model)
 	}
 
-	if key == nil {
-		d.SetId("")
-		return nil
+	// service_account_id has no field in the shared model, it is split off first
+	var object types.Object
+	diags := data.Get(ctx, &object)
+	if diags.HasError() {
+		return diags
 	}
 
-	mapKeyToResourceData(d, key)
-	return nil
-}
+	attributes := make(map[string]attr.Value, len(object.Attributes()))
+	for name, value := range object.Attributes() {
+		attributes[name] = value
+	}
+	serviceAccountID, _ := attributes["service_account_id"].(types.String)
+	delete(attributes, "service_account_id")
 
-func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*Client)
+	keyObject, d := types.ObjectValue(keyResourceAttributeTypes(ctx, false), attributes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(keyObject.As(ctx, model, basetypes.ObjectAsOptions{})...)
+	model.ServiceAccountID = serviceAccountID
+	return diags
+}
 
-	key := &Key{Key: d.Id()}
-	mapResourceDataToKey(d, key)
+// keyTarget is the plan or state a key is written to.
+type keyTarget interface {
+	Set(ctx context.Context, val interface{}) diag.Diagnostics
+}
 
-	_, err := c.UpdateKey(key)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating key: %s", err))
+// set writes a key to a plan or state.
+func (r *keyResource) set(ctx context.Context, target keyTarget, model *keyResourceModel) diag.Diagnostics {
+	object, diags := r.objectValue(ctx, model)
+	if diags.HasError() {
+		return diags
 	}
-
-	return resourceKeyRead(ctx, d, m)
+	diags.Append(target.Set(ctx, object)...)
+	return diags
 }
 
-func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*Client)
+// objectValue converts a key into the object value of its resource type.
+func (r *keyResource) objectValue(ctx context.Context, model *keyResourceModel) (types.Object, diag.Diagnostics) {
+	object, diags := types.ObjectValueFrom(ctx, keyResourceAttributeTypes(ctx, false), model)
+	if diags.HasError() || !r.serviceAccount {
+		return object, diags
+	}
 
-	err := c.DeleteKey(d.Id())
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting key: %s", err))
+	attributes := make(map[string]attr.Value, len(object.Attributes())+1)
+	for name, value := range object.Attributes() {
+		attributes[name] = value
 	}
+	attributes["service_account_id"] = model.ServiceAccountID
 
-	d.SetId("")
-	return nil
+	object, d := types.ObjectValue(keyResourceAttributeTypes(ctx, true), attributes)
+	diags.Append(d...)
+	return object, diags
 }
 
-func mapResourceDataToKey(d *schema.ResourceData, key *Key) {
-	key.Models = expandStringList(d.Get("models").([]interface{}))
-	key.MaxBudget = d.Get("max_budget").(float64)
-	key.UserID = d.Get("user_id").(string)
-	key.TeamID = d.Get("team_id").(string)
-	key.MaxParallelRequests = d.Get("max_parallel_requests").(int)
-	key.Metadata = d.Get("metadata").(map[string]interface{})
-	key.TPMLimit = d.Get("tpm_limit").(int)
-	key.RPMLimit = d.Get("rpm_limit").(int)
-	key.BudgetDuration = d.Get("budget_duration").(string)
-	key.AllowedCacheControls = expandStringList(d.Get("allowed_cache_controls").([]interface{}))
-	key.SoftBudget = d.Get("soft_budget").(float64)
-	key.KeyAlias = d.Get("key_alias").(string)
-	key.Duration = d.Get("duration").(string)
-	key.Aliases = d.Get("aliases").(map[string]interface{})
-	key.Config = d.Get("config").(map[string]interface{})
-	key.Permissions = d.Get("permissions").(map[string]interface{})
-	key.ModelMaxBudget = d.Get("model_max_budget").(map[string]interface{})
-	key.ModelRPMLimit = d.Get("model_rpm_limit").(map[string]interface{})
-	key.ModelTPMLimit = d.Get("model_tpm_limit").(map[string]interface{})
-	key.Guardrails = expandStringList(d.Get("guardrails").([]interface{}))
-	key.Blocked = d.Get("blocked").(bool)
-	key.Tags = expandStringList(d.Get("tags").([]interface{}))
+// keyResourceAttributeTypes returns the attribute types of a key resource.
+func keyResourceAttributeTypes(ctx context.Context, serviceAccount bool) map[string]attr.Type {
+	return keyResourceSchema(ctx, serviceAccount).Type().(types.ObjectType).AttrTypes
 }
 
-func mapKeyToResourceData(d *schema.ResourceData, key *Key) {
-	d.Set("key", key.Key)
-
-	if len(key.Models) > 0 {
-		d.Set("models", key.Models)
-	}
-	if key.MaxBudget != 0 {
-		d.Set("max_budget", key.MaxBudget)
-	}
-	if key.UserID != "" {
-		d.Set("user_id", key.UserID)
+// ValidateConfig checks the arguments that conflict with a block, which ConflictsWith
+// validators cannot express as an absent block is an empty list rather than null.
+func (r *keyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config keyResourceModel
+	resp.Diagnostics.Append(r.get(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if key.TeamID != "" {
-		d.Set("team_id", key.TeamID)
-	}
-	if key.MaxParallelRequests != 0 {
-		d.Set("max_parallel_requests", key.MaxParallelRequests)
-	}
-	if key.Metadata != nil {
-		d.Set("metadata", key.Metadata)
-	}
-	if key.TPMLimit != 0 {
-		d.Set("tpm_limit", key.TPMLimit)
-	}
-	if key.RPMLimit != 0 {
-		d.Set("rpm_limit", key.RPMLimit)
+
+	if config.ModelLimits.IsUnknown() || len(config.ModelLimits.Elements()) == 0 {
+		return
 	}
-	if key.BudgetDuration != "" {
-		d.Set("budget_duration", key.BudgetDuration)
+	for name, value := range map[string]types.Map{
+		"model_max_budget": config.ModelMaxBudget,
+		"model_rpm_limit":  config.ModelRPMLimit,
+		"model_tpm_limit":  config.ModelTPMLimit,
+	} {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Conflicting configuration arguments",
+				fmt.Sprintf("%q cannot be specified when \"model_limits\" is specified", name),
+			)
+		}
 	}
-	if len(key.AllowedCacheControls) > 0 {
-		d.Set("allowed_cache_controls", key.AllowedCacheControls)
+}
+
+// ModifyPlan plans a replacement of keys that have already expired and checks
+// the models, model limits and object permissions against the proxy.
+func (r *keyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The key is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
 	}
-	if key.SoftBudget != 0 {
-		d.Set("soft_budget", key.SoftBudget)
+
+	var plan keyResourceModel
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if key.KeyAlias != "" {
-		d.Set("key_alias", key.KeyAlias)
+
+	var state *keyResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &keyResourceModel{}
+		resp.Diagnostics.Append(r.get(ctx, req.State, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
-	if key.Duration != "" {
-		d.Set("duration", key.Duration)
+
+	if state != nil {
+		expires, err := parseKeyExpires(state.Expires.ValueString())
+		switch {
+		case err == nil && !expires.IsZero() && !time.Now().Before(expires):
+			log.Printf("[INFO] Key %s expired at %s, planning replacement", state.KeyAlias.ValueString(), expires.Format(time.RFC3339))
+			plan.Expires = types.StringUnknown()
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires"))
+		case !plan.Duration.Equal(state.Duration):
+			// A new duration moves the expiry
+			plan.Expires = types.StringUnknown()
+		}
 	}
-	if key.Aliases != nil {
-		d.Set("aliases", key.Aliases)
+
+	if r.client != nil {
+		client := r.client.withContext(ctx)
+		resp.Diagnostics.Append(validateKeyModelNames(ctx, client, &plan, state)...)
+		resp.Diagnostics.Append(validateKeyObjectPermission(ctx, client, &plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
-	if key.Config != nil {
-		d.Set("config", key.Config)
+
+	resp.Diagnostics.Append(r.set(ctx, &resp.Plan, &plan)...)
+}
+
+// validateKeyModelNames checks the planned models and model_limits when
+// validate_model_names is set.
+func validateKeyModelNames(ctx context.Context, client *Client, plan, state *keyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !client.ValidateModelNames {
+		return diags
 	}
-	if key.Permissions != nil {
-		d.Set("permissions", key.Permissions)
+
+	if !plan.Models.IsNull() && !plan.Models.IsUnknown() && (state == nil || !plan.Models.Equal(state.Models)) {
+		if err := checkModelNames(client, "models", knownStrings(plan.Models.Elements())); err != nil {
+			diags.AddAttributeError(path.Root("models"), "Invalid models", err.Error())
+		}
 	}
-	if key.ModelMaxBudget != nil {
-		d.Set("model_max_budget", key.ModelMaxBudget)
+
+	if !plan.ModelLimits.IsUnknown() && (state == nil || !plan.ModelLimits.Equal(state.ModelLimits)) {
+		var models []string
+		for _, limit := range modelLimitsBlockElements(ctx, plan.ModelLimits) {
+			// Unknown values are validated once they are known
+			if !limit.Model.IsUnknown() {
+				models = append(models, limit.Model.ValueString())
+			}
+		}
+		if err := checkModelNames(client, "model_limits", models); err != nil {
+			diags.AddAttributeError(path.Root("model_limits"), "Invalid model_limits", err.Error())
+		}
 	}
-	if key.ModelRPMLimit != nil {
-		d.Set("model_rpm_limit", key.ModelRPMLimit)
+
+	return diags
+}
+
+// validateKeyObjectPermission checks that the objects referenced by object_permission exist.
+func validateKeyObjectPermission(ctx context.Context, client *Client, plan, state *keyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.ObjectPermission.IsUnknown() || (state != nil && plan.ObjectPermission.Equal(state.ObjectPermission)) {
+		return diags
 	}
-	if key.ModelTPMLimit != nil {
-		d.Set("model_tpm_limit", key.ModelTPMLimit)
+
+	permission, ok := expandObjectPermissionBlock(ctx, plan.ObjectPermission)
+	if !ok {
+		return diags
 	}
-	if len(key.Guardrails) > 0 {
-		d.Set("guardrails", key.Guardrails)
+
+	serverIDs := permission.MCPServers
+	for serverID := range permission.MCPToolPermissions {
+		serverIDs = append(serverIDs, serverID)
 	}
-	d.Set("blocked", key.Blocked)
-	if len(key.Tags) > 0 {
-		d.Set("tags", key.Tags)
+	if err := checkObjectPermission(client, serverIDs, permission.MCPAccessGroups, permission.VectorStores); err != nil {
+		diags.AddAttributeError(path.Root("object_permission"), "Invalid object_permission", err.Error())
 	}
-	if key.Spend != 0 {
-		d.Set("spend", key.Spend)
+	return diags
+}
+
+// UpgradeState converts state written by the SDK implementation, which stored
+// unset attributes as "", 0, false or empty collections, so that they are null
+// like in the configuration.
+func (r *keyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := keyResourceSchema(ctx, r.serviceAccount)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state keyResourceModel
+				resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				strings := []*types.String{
+					&state.TeamID, &state.BudgetDuration, &state.KeyAlias, &state.Duration,
+					&state.AliasesJSON, &state.ConfigJSON, &state.PermissionsJSON, &state.PGPKey, &state.AgeRecipient,
+				}
+				// The user_id of a service account key is computed
+				if !r.serviceAccount {
+					strings = append(strings, &state.UserID)
+				}
+				for _, s := range strings {
+					if s.ValueString() == "" {
+						*s = types.StringNull()
+					}
+				}
+				for _, i := range []*types.Int64{&state.MaxParallelRequests, &state.TPMLimit, &state.RPMLimit} {
+					if i.ValueInt64() == 0 {
+						*i = types.Int64Null()
+					}
+				}
+				for _, f := range []*types.Float64{&state.MaxBudget, &state.SoftBudget} {
+					if f.ValueFloat64() == 0 {
+						*f = types.Float64Null()
+					}
+				}
+				if !state.Blocked.ValueBool() {
+					state.Blocked = types.BoolNull()
+				}
+				for _, l := range []*types.List{&state.Models, &state.AllowedCacheControls, &state.Guardrails, &state.Tags} {
+					if len(l.Elements()) == 0 {
+						*l = types.ListNull(types.StringType)
+					}
+				}
+				for _, m := range []*types.Map{
+					&state.Metadata, &state.Aliases, &state.Config, &state.Permissions,
+					&state.ModelMaxBudget, &state.ModelRPMLimit, &state.ModelTPMLimit,
+				} {
+					if len(m.Elements()) == 0 {
+						*m = types.MapNull(m.ElementType(ctx))
+					}
+				}
+
+				var diags diag.Diagnostics
+				state.ModelLimits, diags = upgradeModelLimitsBlock(ctx, state.ModelLimits)
+				resp.Diagnostics.Append(diags...)
+				state.ObjectPermission, diags = upgradeObjectPermissionBlock(ctx, state.ObjectPermission)
+				resp.Diagnostics.Append(diags...)
+				state.SecretSink, diags = upgradeKeySecretSinkBlock(ctx, state.SecretSink)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(r.set(ctx, &resp.State, &state)...)
+			},
+		},
 	}
 }
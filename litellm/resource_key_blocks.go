@@ -0,0 +1,373 @@
+package litellm
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// keyModelLimitModel maps an element of the model_limits block of a key.
+type keyModelLimitModel struct {
+	Model          types.String  `tfsdk:"model"`
+	MaxBudget      types.Float64 `tfsdk:"max_budget"`
+	BudgetDuration types.String  `tfsdk:"budget_duration"`
+	RPM            types.Int64   `tfsdk:"rpm"`
+	TPM            types.Int64   `tfsdk:"tpm"`
+}
+
+// objectPermissionModel maps the object_permission block of a key.
+type objectPermissionModel struct {
+	MCPServers         types.Set `tfsdk:"mcp_servers"`
+	MCPAccessGroups    types.Set `tfsdk:"mcp_access_groups"`
+	MCPToolPermissions types.Set `tfsdk:"mcp_tool_permissions"`
+	VectorStores       types.Set `tfsdk:"vector_stores"`
+}
+
+// mcpToolPermissionModel maps an element of the mcp_tool_permissions block.
+type mcpToolPermissionModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+	Tools    types.Set    `tfsdk:"tools"`
+}
+
+// modelLimitsBlock is the framework counterpart of modelLimitsSchema(true).
+func modelLimitsBlock() schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		Description: "Per-model budgets and rate limits",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"model": schema.StringAttribute{
+					Required:    true,
+					Description: "Model name or access group the limits apply to",
+				},
+				"max_budget": schema.Float64Attribute{
+					Optional:    true,
+					Description: "Maximum spend on the model",
+				},
+				"budget_duration": schema.StringAttribute{
+					Optional:    true,
+					Description: "Period after which the model budget is reset, e.g. 30d",
+					Validators: []validator.String{
+						keyDurationValidator(),
+					},
+				},
+				"rpm": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Requests per minute allowed for the model",
+				},
+				"tpm": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Tokens per minute allowed for the model",
+				},
+			},
+		},
+	}
+}
+
+// objectPermissionBlock is the framework counterpart of objectPermissionSchema.
+func objectPermissionBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "MCP servers, tools and vector stores that may be used",
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"mcp_servers": schema.SetAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "IDs of the MCP servers that may be used",
+				},
+				"mcp_access_groups": schema.SetAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "MCP access groups whose servers may be used",
+				},
+				"vector_stores": schema.SetAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "IDs of the vector stores that may be used",
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"mcp_tool_permissions": schema.SetNestedBlock{
+					Description: "Restrict the tools that may be called on an MCP server",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"server_id": schema.StringAttribute{
+								Required:    true,
+								Description: "ID of the MCP server",
+							},
+							"tools": schema.SetAttribute{
+								Required:    true,
+								ElementType: types.StringType,
+								Description: "Names of the tools that may be called",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// modelLimitsBlockElements returns the known elements of a model_limits block.
+func modelLimitsBlockElements(ctx context.Context, value types.Set) []keyModelLimitModel {
+	var limits []keyModelLimitModel
+	for _, element := range value.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+		var limit keyModelLimitModel
+		if diags := object.As(ctx, &limit, basetypes.ObjectAsOptions{}); diags.HasError() {
+			continue
+		}
+		limits = append(limits, limit)
+	}
+	return limits
+}
+
+// expandKeyModelLimitsBlock converts the model_limits block into the
+// model_max_budget, model_rpm_limit and model_tpm_limit fields of a key. Every
+// limit set in the configuration is sent, including zero.
+func expandKeyModelLimitsBlock(limits []keyModelLimitModel) (budgets, rpm, tpm map[string]interface{}) {
+	budgets = map[string]interface{}{}
+	rpm = map[string]interface{}{}
+	tpm = map[string]interface{}{}
+
+	for _, limit := range limits {
+		model := limit.Model.ValueString()
+		if known(limit.MaxBudget) {
+			budget := map[string]interface{}{keyModelBudgetFields[0]: limit.MaxBudget.ValueFloat64()}
+			if known(limit.BudgetDuration) {
+				budget[keyModelBudgetFields[1]] = limit.BudgetDuration.ValueString()
+			}
+			budgets[model] = budget
+		}
+		if known(limit.RPM) {
+			rpm[model] = limit.RPM.ValueInt64()
+		}
+		if known(limit.TPM) {
+			tpm[model] = limit.TPM.ValueInt64()
+		}
+	}
+	return budgets, rpm, tpm
+}
+
+// flattenKeyModelLimitsBlock converts the model limits returned by the API into
+// the model_limits block. A zero limit is null unless it was set in prior.
+func flattenKeyModelLimitsBlock(ctx context.Context, limits []modelLimit, prior []keyModelLimitModel) (types.Set, diag.Diagnostics) {
+	priorByModel := make(map[string]keyModelLimitModel, len(prior))
+	for _, limit := range prior {
+		priorByModel[limit.Model.ValueString()] = limit
+	}
+
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Model < limits[j].Model })
+
+	elements := make([]keyModelLimitModel, 0, len(limits))
+	for _, limit := range limits {
+		p, ok := priorByModel[limit.Model]
+		if !ok {
+			p = keyModelLimitModel{
+				MaxBudget:      types.Float64Null(),
+				BudgetDuration: types.StringNull(),
+				RPM:            types.Int64Null(),
+				TPM:            types.Int64Null(),
+			}
+		}
+
+		element := keyModelLimitModel{
+			Model:          types.StringValue(limit.Model),
+			MaxBudget:      types.Float64Value(limit.MaxBudget),
+			BudgetDuration: types.StringValue(limit.BudgetDuration),
+			RPM:            types.Int64Value(int64(limit.RPM)),
+			TPM:            types.Int64Value(int64(limit.TPM)),
+		}
+		if limit.MaxBudget == 0 && p.MaxBudget.IsNull() {
+			element.MaxBudget = types.Float64Null()
+		}
+		if limit.BudgetDuration == "" && p.BudgetDuration.IsNull() {
+			element.BudgetDuration = types.StringNull()
+		}
+		if limit.RPM == 0 && p.RPM.IsNull() {
+			element.RPM = types.Int64Null()
+		}
+		if limit.TPM == 0 && p.TPM.IsNull() {
+			element.TPM = types.Int64Null()
+		}
+		elements = append(elements, element)
+	}
+
+	return types.SetValueFrom(ctx, modelLimitsBlock().NestedObject.Type(), elements)
+}
+
+// upgradeModelLimitsBlock nulls the zero limits the SDK implementation stored
+// for the model_limits block.
+func upgradeModelLimitsBlock(ctx context.Context, value types.Set) (types.Set, diag.Diagnostics) {
+	var limits []modelLimit
+	for _, limit := range modelLimitsBlockElements(ctx, value) {
+		limits = append(limits, modelLimit{
+			Model:          limit.Model.ValueString(),
+			MaxBudget:      limit.MaxBudget.ValueFloat64(),
+			BudgetDuration: limit.BudgetDuration.ValueString(),
+			RPM:            int(limit.RPM.ValueInt64()),
+			TPM:            int(limit.TPM.ValueInt64()),
+		})
+	}
+	return flattenKeyModelLimitsBlock(ctx, limits, nil)
+}
+
+// expandObjectPermissionBlock converts the object_permission block into the
+// request payload. It returns false when the block is not set. Unknown values
+// are left out.
+func expandObjectPermissionBlock(ctx context.Context, value types.List) (*ObjectPermission, bool) {
+	elements := value.Elements()
+	if len(elements) == 0 {
+		return nil, false
+	}
+	object, ok := elements[0].(types.Object)
+	if !ok || object.IsNull() || object.IsUnknown() {
+		return nil, false
+	}
+
+	var block objectPermissionModel
+	if diags := object.As(ctx, &block, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, false
+	}
+
+	permission := &ObjectPermission{
+		MCPServers:         knownStrings(block.MCPServers.Elements()),
+		MCPAccessGroups:    knownStrings(block.MCPAccessGroups.Elements()),
+		MCPToolPermissions: map[string][]string{},
+		VectorStores:       knownStrings(block.VectorStores.Elements()),
+	}
+	for _, element := range block.MCPToolPermissions.Elements() {
+		toolObject, ok := element.(types.Object)
+		if !ok || toolObject.IsNull() || toolObject.IsUnknown() {
+			continue
+		}
+		var toolPermission mcpToolPermissionModel
+		if diags := toolObject.As(ctx, &toolPermission, basetypes.ObjectAsOptions{}); diags.HasError() || toolPermission.ServerID.IsUnknown() {
+			continue
+		}
+		permission.MCPToolPermissions[toolPermission.ServerID.ValueString()] = knownStrings(toolPermission.Tools.Elements())
+	}
+	return permission, true
+}
+
+// flattenObjectPermissionBlock converts an object permission returned by the API
+// into the object_permission block. An empty list is null unless it was set in prior.
+func flattenObjectPermissionBlock(ctx context.Context, permission *ObjectPermission, prior types.List) (types.List, diag.Diagnostics) {
+	blockType := objectPermissionBlock().NestedObject.Type()
+	if permission == nil || (len(permission.MCPServers) == 0 && len(permission.MCPAccessGroups) == 0 &&
+		len(permission.MCPToolPermissions) == 0 && len(permission.VectorStores) == 0) {
+		return types.ListValueMust(blockType, []attr.Value{}), nil
+	}
+
+	p := objectPermissionModel{
+		MCPServers:      types.SetNull(types.StringType),
+		MCPAccessGroups: types.SetNull(types.StringType),
+		VectorStores:    types.SetNull(types.StringType),
+	}
+	if elements := prior.Elements(); len(elements) > 0 {
+		if object, ok := elements[0].(types.Object); ok && !object.IsNull() && !object.IsUnknown() {
+			object.As(ctx, &p, basetypes.ObjectAsOptions{})
+		}
+	}
+
+	var diags diag.Diagnostics
+	stringSet := func(values []string, prior types.Set) types.Set {
+		if len(values) == 0 && prior.IsNull() {
+			return types.SetNull(types.StringType)
+		}
+		set, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(values))
+		diags.Append(d...)
+		return set
+	}
+
+	serverIDs := make([]string, 0, len(permission.MCPToolPermissions))
+	for serverID := range permission.MCPToolPermissions {
+		serverIDs = append(serverIDs, serverID)
+	}
+	sort.Strings(serverIDs)
+
+	toolPermissions := make([]mcpToolPermissionModel, 0, len(serverIDs))
+	for _, serverID := range serverIDs {
+		tools, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(permission.MCPToolPermissions[serverID]))
+		diags.Append(d...)
+		toolPermissions = append(toolPermissions, mcpToolPermissionModel{
+			ServerID: types.StringValue(serverID),
+			Tools:    tools,
+		})
+	}
+	toolPermissionType := objectPermissionBlock().NestedObject.Blocks["mcp_tool_permissions"].(schema.SetNestedBlock).NestedObject.Type()
+	toolPermissionSet, d := types.SetValueFrom(ctx, toolPermissionType, toolPermissions)
+	diags.Append(d...)
+
+	block := objectPermissionModel{
+		MCPServers:         stringSet(permission.MCPServers, p.MCPServers),
+		MCPAccessGroups:    stringSet(permission.MCPAccessGroups, p.MCPAccessGroups),
+		MCPToolPermissions: toolPermissionSet,
+		VectorStores:       stringSet(permission.VectorStores, p.VectorStores),
+	}
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	list, d := types.ListValueFrom(ctx, blockType, []objectPermissionModel{block})
+	diags.Append(d...)
+	return list, diags
+}
+
+// upgradeObjectPermissionBlock nulls the empty lists the SDK implementation
+// stored for the object_permission block.
+func upgradeObjectPermissionBlock(ctx context.Context, value types.List) (types.List, diag.Diagnostics) {
+	var blocks []objectPermissionModel
+	diags := value.ElementsAs(ctx, &blocks, false)
+	if diags.HasError() {
+		return value, diags
+	}
+
+	for i := range blocks {
+		for _, s := range []*types.Set{&blocks[i].MCPServers, &blocks[i].MCPAccessGroups, &blocks[i].VectorStores} {
+			if len(s.Elements()) == 0 {
+				*s = types.SetNull(types.StringType)
+			}
+		}
+	}
+
+	upgraded, d := types.ListValueFrom(ctx, value.ElementType(ctx), blocks)
+	diags.Append(d...)
+	return upgraded, diags
+}
+
+// known reports whether a value is set and known.
+func known(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// knownStrings returns the known, non-null values of a list or set of strings.
+func knownStrings(elements []attr.Value) []string {
+	values := make([]string, 0, len(elements))
+	for _, element := range elements {
+		if s, ok := element.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			values = append(values, s.ValueString())
+		}
+	}
+	return values
+}
+
+// nonNilStrings returns values, or an empty slice when it is nil.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
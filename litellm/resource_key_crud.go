@@ -0,0 +1,548 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// keyKind returns the name of the kind of key the resource manages, for messages.
+func (r *keyResource) keyKind() string {
+	if r.serviceAccount {
+		return "service account key"
+	}
+	return "key"
+}
+
+func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan keyResourceModel
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.client.withContext(ctx)
+	data := r.buildKeyRequest(ctx, &plan, nil)
+
+	var createdKey *Key
+	var err error
+	if r.serviceAccount {
+		createdKey, err = client.CreateServiceAccountKey(data)
+	} else {
+		createdKey, err = client.CreateKey(data)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating %s", r.keyKind()), err.Error())
+		return
+	}
+
+	if err := setCreatedKeyID(ctx, client, &plan, createdKey); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating %s", r.keyKind()), err.Error())
+		// A key whose ID is known is saved, so that it is tainted rather than leaked
+		if plan.ID.IsUnknown() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.setComputedKeyState(ctx, client, &plan)...)
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, &plan)...)
+}
+
+func (r *keyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state keyResourceModel
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	key, err := r.client.withContext(ctx).GetKey(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading %s", r.keyKind()), err.Error())
+		return
+	}
+	if key == nil {
+		log.Printf("[WARN] Key %s not found, removing from state", state.KeyAlias.ValueString())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.splitServiceAccountID(&state, key)
+
+	// expires is always set once the key exists, it is only null right after an import
+	if state.Expires.IsNull() {
+		resp.Diagnostics.Append(importKeyState(ctx, &state, key)...)
+	} else {
+		resp.Diagnostics.Append(refreshKeyState(ctx, &state, key)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, &state)...)
+}
+
+func (r *keyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state keyResourceModel
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client := r.client.withContext(ctx)
+	data := r.buildKeyRequest(ctx, &plan, &state)
+	data["key"] = state.ID.ValueString()
+
+	if _, err := client.UpdateKey(data); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating %s", r.keyKind()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.setComputedKeyState(ctx, client, &plan)...)
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, &plan)...)
+}
+
+func (r *keyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state keyResourceModel
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if err := r.client.withContext(ctx).DeleteKey(state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting %s", r.keyKind()), err.Error())
+	}
+}
+
+// setComputedKeyState reads a key after it was written and sets the computed
+// attributes, which the write response does not hold.
+func (r *keyResource) setComputedKeyState(ctx context.Context, client *Client, plan *keyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	key, err := client.GetKey(plan.ID.ValueString())
+	if err == nil && key == nil {
+		err = fmt.Errorf("key not found after it was written")
+	}
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error reading %s", r.keyKind()), err.Error())
+		key = &Key{}
+	}
+	r.splitServiceAccountID(plan, key)
+
+	plan.Expires = types.StringValue(key.Expires)
+	plan.Spend = types.Float64Value(key.Spend)
+	if r.serviceAccount {
+		plan.UserID = types.StringValue(key.UserID)
+		if plan.ServiceAccountID.IsUnknown() {
+			plan.ServiceAccountID = types.StringValue("")
+		}
+	}
+	return diags
+}
+
+// splitServiceAccountID moves the service_account_id of a service account key
+// out of the metadata, as it is tracked as its own attribute.
+func (r *keyResource) splitServiceAccountID(model *keyResourceModel, key *Key) {
+	if !r.serviceAccount {
+		return
+	}
+	serviceAccountID, ok := key.Metadata["service_account_id"].(string)
+	if !ok {
+		return
+	}
+	model.ServiceAccountID = types.StringValue(serviceAccountID)
+
+	// The metadata may be shared with the read cache, it is copied rather than modified
+	metadata := make(map[string]interface{}, len(key.Metadata))
+	for k, v := range key.Metadata {
+		if k != "service_account_id" {
+			metadata[k] = v
+		}
+	}
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+	key.Metadata = metadata
+}
+
+// buildKeyRequest converts the planned attributes into a key request. Only
+// attributes set in the configuration are sent, so explicit zero and false
+// values reach the API, and attributes removed since the prior state are cleared.
+// prior is nil on create.
+func (r *keyResource) buildKeyRequest(ctx context.Context, plan, prior *keyResourceModel) map[string]interface{} {
+	isCreate := prior == nil
+	if isCreate {
+		prior = &keyResourceModel{}
+	}
+
+	data := map[string]interface{}{}
+
+	putListValue(ctx, data, "models", plan.Models, prior.Models)
+	putFloat64Value(data, "max_budget", plan.MaxBudget, prior.MaxBudget, 1)
+	if !r.serviceAccount {
+		// The service account endpoint ignores user_id
+		putStringValue(data, "user_id", plan.UserID, prior.UserID)
+	}
+	putStringValue(data, "team_id", plan.TeamID, prior.TeamID)
+	putInt64Value(data, "max_parallel_requests", plan.MaxParallelRequests, prior.MaxParallelRequests)
+	putInt64Value(data, "tpm_limit", plan.TPMLimit, prior.TPMLimit)
+	putInt64Value(data, "rpm_limit", plan.RPMLimit, prior.RPMLimit)
+	putStringValue(data, "budget_duration", plan.BudgetDuration, prior.BudgetDuration)
+	putListValue(ctx, data, "allowed_cache_controls", plan.AllowedCacheControls, prior.AllowedCacheControls)
+	putFloat64Value(data, "soft_budget", plan.SoftBudget, prior.SoftBudget, 1)
+	putStringValue(data, "key_alias", plan.KeyAlias, prior.KeyAlias)
+	// A duration sets the expiry relative to the request, it is only sent when it changes
+	if isCreate || !plan.Duration.Equal(prior.Duration) {
+		putStringValue(data, "duration", plan.Duration, prior.Duration)
+	}
+	putListValue(ctx, data, "guardrails", plan.Guardrails, prior.Guardrails)
+	putBoolValue(data, "blocked", plan.Blocked, prior.Blocked)
+	putListValue(ctx, data, "tags", plan.Tags, prior.Tags)
+
+	putMapValue(ctx, data, "metadata", plan.Metadata, prior.Metadata)
+	if r.serviceAccount {
+		r.putServiceAccountID(data, plan)
+	}
+
+	putKeyJSONField(ctx, data, "aliases", plan.Aliases, plan.AliasesJSON, prior.Aliases, prior.AliasesJSON)
+	putKeyJSONField(ctx, data, "config", plan.Config, plan.ConfigJSON, prior.Config, prior.ConfigJSON)
+	putKeyJSONField(ctx, data, "permissions", plan.Permissions, plan.PermissionsJSON, prior.Permissions, prior.PermissionsJSON)
+
+	if len(plan.ModelLimits.Elements()) > 0 {
+		data["model_max_budget"], data["model_rpm_limit"], data["model_tpm_limit"] =
+			expandKeyModelLimitsBlock(modelLimitsBlockElements(ctx, plan.ModelLimits))
+	} else {
+		limitsRemoved := len(prior.ModelLimits.Elements()) > 0
+		for _, field := range []struct {
+			key          string
+			value, prior types.Map
+		}{
+			{"model_max_budget", plan.ModelMaxBudget, prior.ModelMaxBudget},
+			{"model_rpm_limit", plan.ModelRPMLimit, prior.ModelRPMLimit},
+			{"model_tpm_limit", plan.ModelTPMLimit, prior.ModelTPMLimit},
+		} {
+			switch {
+			case !field.value.IsNull() && !field.value.IsUnknown():
+				data[field.key] = mapValueElements(field.value)
+			case !field.prior.IsNull() || limitsRemoved:
+				data[field.key] = map[string]interface{}{}
+			}
+		}
+	}
+
+	if permission, ok := expandObjectPermissionBlock(ctx, plan.ObjectPermission); ok {
+		data["object_permission"] = permission
+	} else if len(prior.ObjectPermission.Elements()) > 0 {
+		// A removed block is sent with empty lists so that access is revoked
+		data["object_permission"] = &ObjectPermission{
+			MCPServers:         []string{},
+			MCPAccessGroups:    []string{},
+			MCPToolPermissions: map[string][]string{},
+			VectorStores:       []string{},
+		}
+	}
+
+	return data
+}
+
+// putServiceAccountID stores the service account ID in the key metadata. It
+// defaults to the key alias.
+func (r *keyResource) putServiceAccountID(data map[string]interface{}, plan *keyResourceModel) {
+	serviceAccountID := plan.ServiceAccountID.ValueString()
+	if serviceAccountID == "" {
+		serviceAccountID = plan.KeyAlias.ValueString()
+	}
+	if serviceAccountID == "" {
+		return
+	}
+
+	metadata := map[string]string{}
+	for k, v := range plan.Metadata.Elements() {
+		if s, ok := v.(types.String); ok {
+			metadata[k] = s.ValueString()
+		}
+	}
+	metadata["service_account_id"] = serviceAccountID
+	data["metadata"] = metadata
+}
+
+// mapValueElements converts a map of strings or numbers into its JSON value.
+func mapValueElements(value types.Map) map[string]interface{} {
+	result := make(map[string]interface{}, len(value.Elements()))
+	for k, v := range value.Elements() {
+		switch v := v.(type) {
+		case types.String:
+			result[k] = v.ValueString()
+		case types.Float64:
+			result[k] = v.ValueFloat64()
+		case types.Int64:
+			result[k] = v.ValueInt64()
+		}
+	}
+	return result
+}
+
+// refreshKeyState updates the configured attributes with the values returned by
+// the API. Attributes that are null in the state are left null.
+func refreshKeyState(ctx context.Context, state *keyResourceModel, key *Key) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The cleartext key is only kept in state when it is not encrypted or delivered elsewhere
+	if !keySecretExcludedFromState(state) {
+		state.Key = types.StringValue(key.Key)
+	}
+	state.Expires = types.StringValue(key.Expires)
+	state.Spend = types.Float64Value(key.Spend)
+
+	for _, field := range []struct {
+		value     string
+		attribute *types.String
+	}{
+		{key.UserID, &state.UserID},
+		{key.TeamID, &state.TeamID},
+		{key.BudgetDuration, &state.BudgetDuration},
+		{key.KeyAlias, &state.KeyAlias},
+	} {
+		*field.attribute = refreshModelString(*field.attribute, field.value)
+	}
+	for _, field := range []struct {
+		value     int
+		attribute *types.Int64
+	}{
+		{key.MaxParallelRequests, &state.MaxParallelRequests},
+		{key.TPMLimit, &state.TPMLimit},
+		{key.RPMLimit, &state.RPMLimit},
+	} {
+		if !field.attribute.IsNull() {
+			*field.attribute = types.Int64Value(int64(field.value))
+		}
+	}
+	for _, field := range []struct {
+		value     float64
+		attribute *types.Float64
+	}{
+		{key.MaxBudget, &state.MaxBudget},
+		{key.SoftBudget, &state.SoftBudget},
+	} {
+		if !field.attribute.IsNull() {
+			*field.attribute = types.Float64Value(field.value)
+		}
+	}
+	if !state.Blocked.IsNull() {
+		state.Blocked = types.BoolValue(key.Blocked)
+	}
+
+	for _, field := range []struct {
+		value     []string
+		attribute *types.List
+	}{
+		{key.Models, &state.Models},
+		{key.AllowedCacheControls, &state.AllowedCacheControls},
+		{key.Guardrails, &state.Guardrails},
+		{key.Tags, &state.Tags},
+	} {
+		if !field.attribute.IsNull() {
+			list, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(field.value))
+			diags.Append(d...)
+			*field.attribute = list
+		}
+	}
+	if !state.Metadata.IsNull() {
+		state.Metadata, _ = flattenKeyJSONField(key.Metadata, types.StringNull())
+	}
+
+	for _, field := range []struct {
+		value            map[string]interface{}
+		attribute        *types.Map
+		encodedAttribute *types.String
+	}{
+		{key.Aliases, &state.Aliases, &state.AliasesJSON},
+		{key.Config, &state.Config, &state.ConfigJSON},
+		{key.Permissions, &state.Permissions, &state.PermissionsJSON},
+	} {
+		if !field.attribute.IsNull() || !field.encodedAttribute.IsNull() {
+			*field.attribute, *field.encodedAttribute = flattenKeyJSONField(field.value, *field.encodedAttribute)
+		}
+	}
+
+	if len(state.ModelLimits.Elements()) > 0 {
+		limits := collectModelLimits(key.ModelRPMLimit, key.ModelTPMLimit, key.ModelMaxBudget)
+		var d diag.Diagnostics
+		state.ModelLimits, d = flattenKeyModelLimitsBlock(ctx, limits, modelLimitsBlockElements(ctx, state.ModelLimits))
+		diags.Append(d...)
+	} else {
+		diags.Append(refreshKeyModelMaps(ctx, state, key, false)...)
+	}
+
+	if len(state.ObjectPermission.Elements()) > 0 || key.ObjectPermission != nil {
+		var d diag.Diagnostics
+		state.ObjectPermission, d = flattenObjectPermissionBlock(ctx, key.ObjectPermission, state.ObjectPermission)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// refreshKeyModelMaps sets the model_max_budget, model_rpm_limit and
+// model_tpm_limit attributes. Unless all is set, only non-null attributes are set.
+func refreshKeyModelMaps(ctx context.Context, state *keyResourceModel, key *Key, all bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, field := range []struct {
+		value       map[string]interface{}
+		elementType attr.Type
+		attribute   *types.Map
+	}{
+		{key.ModelMaxBudget, types.Float64Type, &state.ModelMaxBudget},
+		{key.ModelRPMLimit, types.Int64Type, &state.ModelRPMLimit},
+		{key.ModelTPMLimit, types.Int64Type, &state.ModelTPMLimit},
+	} {
+		if (!all && field.attribute.IsNull()) || (all && len(field.value) == 0) {
+			continue
+		}
+
+		elements := make(map[string]attr.Value, len(field.value))
+		for model, v := range field.value {
+			if field.elementType == types.Int64Type {
+				elements[model] = types.Int64Value(int64(interfaceToInt(v)))
+				continue
+			}
+			// Budgets set through model_limits are {budget_limit, time_period}
+			if budget, ok := v.(map[string]interface{}); ok {
+				v = budget[keyModelBudgetFields[0]]
+			}
+			elements[model] = types.Float64Value(interfaceToFloat(v))
+		}
+		m, d := types.MapValue(field.elementType, elements)
+		diags.Append(d...)
+		*field.attribute = m
+	}
+	return diags
+}
+
+// importKeyState populates the state of an imported key from the API. Values the
+// API returns empty are left null.
+func importKeyState(ctx context.Context, state *keyResourceModel, key *Key) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.Key = types.StringValue(key.Key)
+	state.EncryptedKey = types.StringValue("")
+	state.Expires = types.StringValue(key.Expires)
+	state.Spend = types.Float64Value(key.Spend)
+	state.ModelLimits = types.SetValueMust(modelLimitsBlock().NestedObject.Type(), []attr.Value{})
+	state.SecretSink = types.ListValueMust(keyResourceSchema(ctx, false).Blocks["secret_sink"].Type().(types.ListType).ElemType, []attr.Value{})
+
+	for _, field := range []struct {
+		value     string
+		attribute *types.String
+	}{
+		{key.UserID, &state.UserID},
+		{key.TeamID, &state.TeamID},
+		{key.BudgetDuration, &state.BudgetDuration},
+		{key.KeyAlias, &state.KeyAlias},
+		{key.Duration, &state.Duration},
+	} {
+		if field.value != "" {
+			*field.attribute = types.StringValue(field.value)
+		}
+	}
+	for _, field := range []struct {
+		value     int
+		attribute *types.Int64
+	}{
+		{key.MaxParallelRequests, &state.MaxParallelRequests},
+		{key.TPMLimit, &state.TPMLimit},
+		{key.RPMLimit, &state.RPMLimit},
+	} {
+		if field.value != 0 {
+			*field.attribute = types.Int64Value(int64(field.value))
+		}
+	}
+	for _, field := range []struct {
+		value     float64
+		attribute *types.Float64
+	}{
+		{key.MaxBudget, &state.MaxBudget},
+		{key.SoftBudget, &state.SoftBudget},
+	} {
+		if field.value != 0 {
+			*field.attribute = types.Float64Value(field.value)
+		}
+	}
+	if key.Blocked {
+		state.Blocked = types.BoolValue(true)
+	}
+
+	for _, field := range []struct {
+		value     []string
+		attribute *types.List
+	}{
+		{key.Models, &state.Models},
+		{key.AllowedCacheControls, &state.AllowedCacheControls},
+		{key.Guardrails, &state.Guardrails},
+		{key.Tags, &state.Tags},
+	} {
+		if len(field.value) > 0 {
+			list, d := types.ListValueFrom(ctx, types.StringType, field.value)
+			diags.Append(d...)
+			*field.attribute = list
+		}
+	}
+
+	for _, field := range []struct {
+		value     map[string]interface{}
+		attribute *types.Map
+	}{
+		{key.Metadata, &state.Metadata},
+		{key.Aliases, &state.Aliases},
+		{key.Config, &state.Config},
+		{key.Permissions, &state.Permissions},
+	} {
+		if len(field.value) > 0 {
+			*field.attribute, _ = flattenKeyJSONField(field.value, types.StringNull())
+		}
+	}
+
+	diags.Append(refreshKeyModelMaps(ctx, state, key, true)...)
+
+	var d diag.Diagnostics
+	state.ObjectPermission, d = flattenObjectPermissionBlock(ctx, key.ObjectPermission, types.ListNull(objectPermissionBlock().NestedObject.Type()))
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,191 @@
+package litellm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// keyEncryptionConfigured reports whether the generated key must be encrypted.
+func keyEncryptionConfigured(model *keyResourceModel) bool {
+	return model.PGPKey.ValueString() != "" || model.AgeRecipient.ValueString() != ""
+}
+
+// keySecretExcludedFromState reports whether the cleartext key must be kept out
+// of state, because it is encrypted or delivered to a secret sink instead.
+func keySecretExcludedFromState(model *keyResourceModel) bool {
+	return keyEncryptionConfigured(model) || len(model.SecretSink.Elements()) > 0
+}
+
+// setCreatedKeyID sets the resource ID after a key has been generated and
+// delivers the key to the configured secret sink. When the key is kept out of
+// state the ID is the hashed token, which LiteLLM accepts in place of the key.
+func setCreatedKeyID(ctx context.Context, client *Client, model *keyResourceModel, createdKey *Key) error {
+	if !keySecretExcludedFromState(model) {
+		model.ID = types.StringValue(createdKey.Key)
+		model.Key = types.StringValue(createdKey.Key)
+		model.EncryptedKey = types.StringValue("")
+		return deliverKeySecret(ctx, client, model, createdKey)
+	}
+
+	// The ID is set before encryption and delivery, so that a key that cannot
+	// be encrypted or delivered is saved as tainted instead of being leaked
+	model.ID = types.StringValue(hashKeyToken(createdKey.Key))
+	model.Key = types.StringValue("")
+	model.EncryptedKey = types.StringValue("")
+	if keyEncryptionConfigured(model) {
+		encryptedKey, err := encryptKeySecret(model, createdKey.Key)
+		if err != nil {
+			return err
+		}
+		model.EncryptedKey = types.StringValue(encryptedKey)
+	}
+
+	return deliverKeySecret(ctx, client, model, createdKey)
+}
+
+// encryptKeySecret encrypts a secret with the configured PGP public key or age recipient.
+func encryptKeySecret(model *keyResourceModel, secret string) (string, error) {
+	if pgpKey := model.PGPKey.ValueString(); pgpKey != "" {
+		return encryptWithPGP(pgpKey, secret)
+	}
+	return encryptWithAge(model.AgeRecipient.ValueString(), secret)
+}
+
+// readPGPKey reads a PGP public key, given either ASCII-armored or base64-encoded.
+func readPGPKey(publicKey string) (openpgp.EntityList, error) {
+	var entities openpgp.EntityList
+	var err error
+
+	if strings.HasPrefix(strings.TrimSpace(publicKey), "-----BEGIN") {
+		entities, err = openpgp.ReadArmoredKeyRing(strings.NewReader(publicKey))
+	} else {
+		var decoded []byte
+		decoded, err = base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding pgp_key: %v", err)
+		}
+		entities, err = openpgp.ReadKeyRing(bytes.NewReader(decoded))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading pgp_key: %v", err)
+	}
+
+	for _, entity := range entities {
+		if _, ok := entity.EncryptionKey(time.Now()); ok {
+			return entities, nil
+		}
+	}
+	return nil, fmt.Errorf("pgp_key has no valid encryption key")
+}
+
+// encryptWithPGP encrypts a secret for a PGP public key, given either ASCII-armored
+// or base64-encoded. The result is base64-encoded.
+func encryptWithPGP(publicKey, secret string) (string, error) {
+	entities, err := readPGPKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	w, err := openpgp.Encrypt(&buf, entities, nil, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("error encrypting key with PGP: %v", err)
+	}
+	if _, err := io.WriteString(w, secret); err != nil {
+		return "", fmt.Errorf("error encrypting key with PGP: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("error encrypting key with PGP: %v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// encryptWithAge encrypts a secret for an age X25519 recipient. The result is ASCII-armored.
+func encryptWithAge(recipient, secret string) (string, error) {
+	r, err := age.ParseX25519Recipient(strings.TrimSpace(recipient))
+	if err != nil {
+		return "", fmt.Errorf("error reading age_recipient: %v", err)
+	}
+
+	var buf bytes.Buffer
+	aw := armor.NewWriter(&buf)
+	w, err := age.Encrypt(aw, r)
+	if err != nil {
+		return "", fmt.Errorf("error encrypting key with age: %v", err)
+	}
+	if _, err := io.WriteString(w, secret); err != nil {
+		return "", fmt.Errorf("error encrypting key with age: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("error encrypting key with age: %v", err)
+	}
+	if err := aw.Close(); err != nil {
+		return "", fmt.Errorf("error encrypting key with age: %v", err)
+	}
+
+	return buf.String(), nil
+}
+
+// hashKeyToken returns the hashed token LiteLLM stores for a key.
+func hashKeyToken(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// ageRecipientValidator validates age recipients at plan time.
+type ageRecipientValidator struct{}
+
+func (v ageRecipientValidator) Description(ctx context.Context) string {
+	return "value must be an age X25519 recipient (age1...)"
+}
+
+func (v ageRecipientValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ageRecipientValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := age.ParseX25519Recipient(strings.TrimSpace(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid age recipient",
+			fmt.Sprintf("%s must be an age X25519 recipient (age1...): %v", req.Path, err))
+	}
+}
+
+// pgpKeyValidator validates PGP public keys at plan time.
+type pgpKeyValidator struct{}
+
+func (v pgpKeyValidator) Description(ctx context.Context) string {
+	return "value must be an ASCII-armored or base64-encoded PGP public key"
+}
+
+func (v pgpKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v pgpKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := readPGPKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid PGP key",
+			fmt.Sprintf("%s must be an ASCII-armored or base64-encoded PGP public key: %v", req.Path, err))
+	}
+}
@@ -0,0 +1,167 @@
+package litellm
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgparmor "github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testKeySecret = "sk-test-1234"
+
+func testPGPEntity(t *testing.T) *openpgp.Entity {
+	t.Helper()
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return entity
+}
+
+func testArmoredPGPKey(t *testing.T, entity *openpgp.Entity) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := pgparmor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return buf.String()
+}
+
+func decryptPGP(t *testing.T, entity *openpgp.Entity, encrypted string) string {
+	t.Helper()
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	md, err := openpgp.ReadMessage(bytes.NewReader(data), openpgp.EntityList{entity}, nil, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	plaintext, err := io.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return string(plaintext)
+}
+
+func TestEncryptWithPGP(t *testing.T) {
+	entity := testPGPEntity(t)
+	armored := testArmoredPGPKey(t, entity)
+
+	var raw bytes.Buffer
+	if err := entity.Serialize(&raw); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for name, publicKey := range map[string]string{
+		"armored": armored,
+		"base64":  base64.StdEncoding.EncodeToString(raw.Bytes()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			encrypted, err := encryptWithPGP(publicKey, testKeySecret)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			if got := decryptPGP(t, entity, encrypted); got != testKeySecret {
+				t.Errorf("decrypted %q, want %q", got, testKeySecret)
+			}
+		})
+	}
+
+	if _, err := encryptWithPGP("not a key", testKeySecret); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+}
+
+func TestEncryptWithAge(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	encrypted, err := encryptWithAge(identity.Recipient().String(), testKeySecret)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	r, err := age.Decrypt(armor.NewReader(strings.NewReader(encrypted)), identity)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	plaintext, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if string(plaintext) != testKeySecret {
+		t.Errorf("decrypted %q, want %q", plaintext, testKeySecret)
+	}
+}
+
+func TestPGPKeyValidator(t *testing.T) {
+	ctx := context.Background()
+	armored := testArmoredPGPKey(t, testPGPEntity(t))
+
+	for _, tc := range []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{types.StringValue(armored), false},
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+		{types.StringValue("not a key"), true},
+		{types.StringValue("-----BEGIN PGP PUBLIC KEY BLOCK-----\n\n-----END PGP PUBLIC KEY BLOCK-----"), true},
+	} {
+		req := validator.StringRequest{Path: path.Root("pgp_key"), ConfigValue: tc.value}
+		resp := &validator.StringResponse{}
+		pgpKeyValidator{}.ValidateString(ctx, req, resp)
+		if resp.Diagnostics.HasError() != tc.wantErr {
+			t.Errorf("%s: error = %v, want %v", tc.value, resp.Diagnostics, tc.wantErr)
+		}
+	}
+}
+
+func TestSetCreatedKeyID(t *testing.T) {
+	ctx := context.Background()
+	entity := testPGPEntity(t)
+	createdKey := &Key{Key: testKeySecret}
+
+	model := &keyResourceModel{PGPKey: types.StringValue(testArmoredPGPKey(t, entity))}
+	if err := setCreatedKeyID(ctx, nil, model, createdKey); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if model.ID.ValueString() != hashKeyToken(testKeySecret) {
+		t.Errorf("ID = %s, want the hashed token", model.ID)
+	}
+	if model.Key.ValueString() != "" {
+		t.Errorf("the cleartext key is stored in state")
+	}
+	if got := decryptPGP(t, entity, model.EncryptedKey.ValueString()); got != testKeySecret {
+		t.Errorf("decrypted %q, want %q", got, testKeySecret)
+	}
+
+	// A key that cannot be encrypted keeps its ID, so that it is saved as tainted
+	model = &keyResourceModel{AgeRecipient: types.StringValue("age1invalid")}
+	if err := setCreatedKeyID(ctx, nil, model, createdKey); err == nil {
+		t.Fatal("expected an encryption error")
+	}
+	if model.ID.ValueString() != hashKeyToken(testKeySecret) {
+		t.Errorf("ID = %s, want the hashed token", model.ID)
+	}
+}
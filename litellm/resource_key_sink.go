@@ -0,0 +1,145 @@
+package litellm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// keySecretSinkModel maps the secret_sink block, which describes where a
+// generated key is delivered when the key is created, including as a replacement.
+type keySecretSinkModel struct {
+	FilePath       types.String `tfsdk:"file_path"`
+	WebhookURL     types.String `tfsdk:"webhook_url"`
+	WebhookHeaders types.Map    `tfsdk:"webhook_headers"`
+}
+
+// KeySecretWebhookPayload is the body posted to a secret sink webhook.
+type KeySecretWebhookPayload struct {
+	Key      string `json:"key"`
+	Token    string `json:"token"`
+	KeyAlias string `json:"key_alias,omitempty"`
+	TeamID   string `json:"team_id,omitempty"`
+	Expires  string `json:"expires,omitempty"`
+}
+
+// deliverKeySecret writes a newly generated key to the configured secret sink.
+func deliverKeySecret(ctx context.Context, client *Client, model *keyResourceModel, createdKey *Key) error {
+	sinks := model.SecretSink.Elements()
+	if len(sinks) == 0 {
+		return nil
+	}
+
+	var sink keySecretSinkModel
+	if diags := sinks[0].(types.Object).As(ctx, &sink, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return fmt.Errorf("error reading secret_sink: %v", diags)
+	}
+
+	if filePath := sink.FilePath.ValueString(); filePath != "" {
+		if err := writeKeySecretFile(filePath, createdKey.Key); err != nil {
+			return err
+		}
+		log.Printf("[INFO] Key written to %s", filePath)
+	}
+
+	if webhookURL := sink.WebhookURL.ValueString(); webhookURL != "" {
+		headers := make(map[string]string, len(sink.WebhookHeaders.Elements()))
+		for k, v := range sink.WebhookHeaders.Elements() {
+			headers[k] = v.(types.String).ValueString()
+		}
+		payload := KeySecretWebhookPayload{
+			Key:      createdKey.Key,
+			Token:    hashKeyToken(createdKey.Key),
+			KeyAlias: createdKey.KeyAlias,
+			TeamID:   createdKey.TeamID,
+			Expires:  createdKey.Expires,
+		}
+		if err := postKeySecretWebhook(client, webhookURL, headers, payload); err != nil {
+			return err
+		}
+		log.Printf("[INFO] Key posted to webhook %s", webhookURL)
+	}
+
+	return nil
+}
+
+// upgradeKeySecretSinkBlock nulls the empty arguments the SDK implementation
+// stored for the secret_sink block.
+func upgradeKeySecretSinkBlock(ctx context.Context, value types.List) (types.List, diag.Diagnostics) {
+	var sinks []keySecretSinkModel
+	diags := value.ElementsAs(ctx, &sinks, false)
+	if diags.HasError() {
+		return value, diags
+	}
+
+	for i := range sinks {
+		for _, s := range []*types.String{&sinks[i].FilePath, &sinks[i].WebhookURL} {
+			if s.ValueString() == "" {
+				*s = types.StringNull()
+			}
+		}
+		if len(sinks[i].WebhookHeaders.Elements()) == 0 {
+			sinks[i].WebhookHeaders = types.MapNull(types.StringType)
+		}
+	}
+
+	upgraded, d := types.ListValueFrom(ctx, value.ElementType(ctx), sinks)
+	diags.Append(d...)
+	return upgraded, diags
+}
+
+// writeKeySecretFile writes the key to a file readable only by the current user.
+func writeKeySecretFile(path, secret string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("error creating directory for %s: %v", path, err)
+	}
+	if err := ioutil.WriteFile(path, []byte(secret), 0600); err != nil {
+		return fmt.Errorf("error writing key to %s: %v", path, err)
+	}
+	// WriteFile does not change the mode of an existing file
+	if err := os.Chmod(path, 0600); err != nil {
+		return fmt.Errorf("error setting permissions on %s: %v", path, err)
+	}
+	return nil
+}
+
+// postKeySecretWebhook posts the key to a webhook through the HTTP client of
+// the provider, within the operation timeout.
+func postKeySecretWebhook(client *Client, url string, headers map[string]string, payload KeySecretWebhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error marshaling webhook payload: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(client.context(), "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("error creating webhook request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.doExternal(req)
+	if err != nil {
+		return fmt.Errorf("error posting key to webhook: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("webhook returned status %s: %s", resp.Status, string(respBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPostKeySecretWebhook(t *testing.T) {
+	var payload KeySecretWebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-key") != "" {
+			t.Error("the LiteLLM API key was sent to the webhook")
+		}
+		if got := r.Header.Get("X-Vault-Token"); got != "token" {
+			t.Errorf("X-Vault-Token = %q, want %q", got, "token")
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("err: %s", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("http://litellm.invalid", "sk-admin", false)
+	err := postKeySecretWebhook(client.withContext(context.Background()), server.URL,
+		map[string]string{"X-Vault-Token": "token"}, KeySecretWebhookPayload{Key: testKeySecret})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if payload.Key != testKeySecret {
+		t.Errorf("key = %q, want %q", payload.Key, testKeySecret)
+	}
+}
+
+func TestPostKeySecretWebhookContext(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := NewClient("http://litellm.invalid", "sk-admin", false)
+	if err := postKeySecretWebhook(client.withContext(ctx), server.URL, nil, KeySecretWebhookPayload{}); err == nil {
+		t.Fatal("expected the webhook to be cancelled with the operation context")
+	}
+}
+
+func TestDeliverKeySecretReplacement(t *testing.T) {
+	var delivered []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload KeySecretWebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("err: %s", err)
+		}
+		delivered = append(delivered, payload.Key)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	filePath := filepath.Join(t.TempDir(), "key")
+	sinkType := keyResourceSchema(ctx, false).Blocks["secret_sink"].Type().(types.ListType).ElemType.(types.ObjectType)
+	sink := types.ObjectValueMust(sinkType.AttrTypes, map[string]attr.Value{
+		"file_path":       types.StringValue(filePath),
+		"webhook_url":     types.StringValue(server.URL),
+		"webhook_headers": types.MapNull(types.StringType),
+	})
+
+	client := NewClient("http://litellm.invalid", "sk-admin", false).withContext(ctx)
+	model := keyResourceModel{SecretSink: types.ListValueMust(sinkType, []attr.Value{sink})}
+
+	// A replacement creates a new key, which is delivered like the original one
+	for _, key := range []string{testKeySecret, "sk-test-5678"} {
+		if err := setCreatedKeyID(ctx, client, &model, &Key{Key: key}); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if model.ID.ValueString() != hashKeyToken(key) || model.Key.ValueString() != "" {
+			t.Errorf("id = %s, key = %s", model.ID, model.Key)
+		}
+		written, err := os.ReadFile(filePath)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if string(written) != key {
+			t.Errorf("file holds %q, want %q", written, key)
+		}
+	}
+
+	if want := []string{testKeySecret, "sk-test-5678"}; !reflect.DeepEqual(delivered, want) {
+		t.Errorf("webhook received %v, want %v", delivered, want)
+	}
+}
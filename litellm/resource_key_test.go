@@ -0,0 +1,235 @@
+package litellm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testKeyStateV0 is the state the SDK implementation of litellm_key wrote, with
+// unset attributes stored as "", 0, false and empty collections.
+const testKeyStateV0 = `{
+  "id": "sk-1234",
+  "key": "sk-1234",
+  "models": ["gpt-4o"],
+  "max_budget": 0,
+  "user_id": "",
+  "team_id": "team-1",
+  "max_parallel_requests": 0,
+  "metadata": {},
+  "tpm_limit": 1000,
+  "rpm_limit": 0,
+  "budget_duration": "",
+  "allowed_cache_controls": [],
+  "soft_budget": 0,
+  "key_alias": "ci",
+  "duration": "",
+  "expires": "",
+  "aliases": {},
+  "aliases_json": "",
+  "config": {},
+  "config_json": "{\"a\": 1}",
+  "permissions": {},
+  "permissions_json": "",
+  "model_max_budget": {},
+  "model_rpm_limit": {},
+  "model_tpm_limit": {},
+  "model_limits": [{"model": "gpt-4o", "max_budget": 0, "budget_duration": "", "rpm": 10, "tpm": 0}],
+  "object_permission": [{"mcp_servers": ["server-1"], "mcp_access_groups": [], "mcp_tool_permissions": [], "vector_stores": []}],
+  "guardrails": [],
+  "blocked": false,
+  "tags": [],
+  "spend": 1.5,
+  "pgp_key": "",
+  "age_recipient": "",
+  "encrypted_key": "",
+  "secret_sink": []
+}`
+
+func TestKeyResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	providerServer, err := testAccProtoV6ProviderFactories["litellm"]()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stateType := schemaResp.ResourceSchemas["litellm_key"].ValueType()
+
+	resp, err := providerServer.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "litellm_key",
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: []byte(testKeyStateV0)},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	upgraded, err := resp.UpgradedState.Unmarshal(stateType)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := upgraded.As(&attributes); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Unset attributes become null, configured and computed values are kept
+	for _, name := range []string{
+		"max_budget", "user_id", "max_parallel_requests", "metadata", "rpm_limit", "budget_duration",
+		"allowed_cache_controls", "soft_budget", "duration", "aliases", "aliases_json", "config",
+		"model_max_budget", "blocked", "tags", "pgp_key", "age_recipient",
+	} {
+		if !attributes[name].IsNull() {
+			t.Errorf("%s: expected null, got %s", name, attributes[name])
+		}
+	}
+	for name, want := range map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, "sk-1234"),
+		"team_id":       tftypes.NewValue(tftypes.String, "team-1"),
+		"tpm_limit":     tftypes.NewValue(tftypes.Number, 1000),
+		"config_json":   tftypes.NewValue(tftypes.String, `{"a": 1}`),
+		"expires":       tftypes.NewValue(tftypes.String, ""),
+		"spend":         tftypes.NewValue(tftypes.Number, 1.5),
+		"encrypted_key": tftypes.NewValue(tftypes.String, ""),
+	} {
+		if !attributes[name].Equal(want) {
+			t.Errorf("%s: expected %s, got %s", name, want, attributes[name])
+		}
+	}
+
+	var limits []tftypes.Value
+	if err := attributes["model_limits"].As(&limits); err != nil || len(limits) != 1 {
+		t.Fatalf("model_limits: expected one element, got %s", attributes["model_limits"])
+	}
+	var limit map[string]tftypes.Value
+	if err := limits[0].As(&limit); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, name := range []string{"max_budget", "budget_duration", "tpm"} {
+		if !limit[name].IsNull() {
+			t.Errorf("model_limits.%s: expected null, got %s", name, limit[name])
+		}
+	}
+	if want := tftypes.NewValue(tftypes.Number, 10); !limit["rpm"].Equal(want) {
+		t.Errorf("model_limits.rpm: expected %s, got %s", want, limit["rpm"])
+	}
+
+	var permissions []tftypes.Value
+	if err := attributes["object_permission"].As(&permissions); err != nil || len(permissions) != 1 {
+		t.Fatalf("object_permission: expected one element, got %s", attributes["object_permission"])
+	}
+	var permission map[string]tftypes.Value
+	if err := permissions[0].As(&permission); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, name := range []string{"mcp_access_groups", "vector_stores"} {
+		if !permission[name].IsNull() {
+			t.Errorf("object_permission.%s: expected null, got %s", name, permission[name])
+		}
+	}
+	if permission["mcp_servers"].IsNull() {
+		t.Errorf("object_permission.mcp_servers: expected a value, got null")
+	}
+}
+
+func TestServiceAccountKeyResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	providerServer, err := testAccProtoV6ProviderFactories["litellm"]()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The user_id of a service account key is computed and kept
+	state := testKeyStateV0[:len(testKeyStateV0)-2] + `,
+  "service_account_id": "ci"
+}`
+	state = strings.Replace(state, `"user_id": ""`, `"user_id": "default_user_id"`, 1)
+
+	resp, err := providerServer.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "litellm_service_account_key",
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: []byte(state)},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	upgraded, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas["litellm_service_account_key"].ValueType())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := upgraded.As(&attributes); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for name, want := range map[string]tftypes.Value{
+		"user_id":            tftypes.NewValue(tftypes.String, "default_user_id"),
+		"service_account_id": tftypes.NewValue(tftypes.String, "ci"),
+		"team_id":            tftypes.NewValue(tftypes.String, "team-1"),
+	} {
+		if !attributes[name].Equal(want) {
+			t.Errorf("%s: expected %s, got %s", name, want, attributes[name])
+		}
+	}
+}
+
+func TestBuildKeyRequest(t *testing.T) {
+	ctx := context.Background()
+	r := &keyResource{}
+
+	plan := &keyResourceModel{
+		TPMLimit: types.Int64Value(0),
+		Blocked:  types.BoolValue(false),
+		KeyAlias: types.StringValue("ci"),
+	}
+	data := r.buildKeyRequest(ctx, plan, nil)
+	if v, ok := data["tpm_limit"]; !ok || v != int64(0) {
+		t.Errorf("tpm_limit = %#v, want 0", v)
+	}
+	if v, ok := data["blocked"]; !ok || v != false {
+		t.Errorf("blocked = %#v, want false", v)
+	}
+	for _, key := range []string{"max_budget", "rpm_limit", "models", "user_id", "object_permission"} {
+		if v, ok := data[key]; ok {
+			t.Errorf("%s = %#v, want it not to be sent", key, v)
+		}
+	}
+
+	prior := &keyResourceModel{
+		TPMLimit: types.Int64Value(1000),
+		Models:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("gpt-4o")}),
+		KeyAlias: types.StringValue("ci"),
+	}
+	plan = &keyResourceModel{KeyAlias: types.StringValue("ci")}
+	data = r.buildKeyRequest(ctx, plan, prior)
+	if v, ok := data["tpm_limit"]; !ok || v != nil {
+		t.Errorf("removed tpm_limit = %#v, want null", v)
+	}
+	if v, ok := data["models"].([]string); !ok || len(v) != 0 {
+		t.Errorf("removed models = %#v, want an empty list", data["models"])
+	}
+	if _, ok := data["rpm_limit"]; ok {
+		t.Errorf("rpm_limit was sent although it was never set")
+	}
+}
@@ -1,133 +1,230 @@
 package litellm
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func buildKeyData(d *schema.ResourceData) map[string]interface{} {
-	keyData := make(map[string]interface{})
+// keyDurationPattern matches the durations accepted by LiteLLM, e.g. 30s, 1h, 30d, 1mo.
+var keyDurationPattern = regexp.MustCompile(`^[0-9]+(s|m|h|d|w|mo)$`)
 
-	if v, ok := d.GetOkExists("models"); ok {
-		models := expandStringList(v.([]interface{}))
-		if len(models) > 0 {
-			keyData["models"] = models
-		}
-	}
-	if v, ok := d.GetOkExists("max_budget"); ok {
-		keyData["max_budget"] = v.(float64)
+// validateKeyDuration validates duration strings of SDK resources at plan time.
+func validateKeyDuration(v interface{}, k string) (warnings []string, errs []error) {
+	value := v.(string)
+	if value != "" && !keyDurationPattern.MatchString(value) {
+		errs = append(errs, fmt.Errorf("%q must be a number followed by s, m, h, d, w or mo (e.g. 30d, 1h), got %q", k, value))
 	}
-	if v, ok := d.GetOkExists("user_id"); ok {
-		keyData["user_id"] = v.(string)
+	return warnings, errs
+}
+
+// parseKeyExpires parses the expires timestamp returned by the API. LiteLLM
+// returns ISO 8601 timestamps that may lack a timezone, those are treated as UTC.
+func parseKeyExpires(expires string) (time.Time, error) {
+	if expires == "" {
+		return time.Time{}, nil
 	}
-	if v, ok := d.GetOkExists("team_id"); ok {
-		keyData["team_id"] = v.(string)
+
+	if t, err := time.Parse(time.RFC3339Nano, expires); err == nil {
+		return t, nil
 	}
-	if v, ok := d.GetOkExists("max_parallel_requests"); ok {
-		keyData["max_parallel_requests"] = v.(int)
+
+	t, err := time.Parse("2006-01-02T15:04:05.999999999", expires)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expires timestamp %q: %v", expires, err)
 	}
-	if v, ok := d.GetOkExists("metadata"); ok {
-		keyData["metadata"] = v.(map[string]interface{})
+	return t.UTC(), nil
+}
+
+// normalizeKeyExpires converts the expires timestamp returned by the API to RFC3339.
+func normalizeKeyExpires(expires string) string {
+	t, err := parseKeyExpires(expires)
+	if err != nil {
+		log.Printf("[WARN] %s", err)
+		return expires
 	}
-	if v, ok := d.GetOkExists("tpm_limit"); ok {
-		keyData["tpm_limit"] = v.(int)
+	if t.IsZero() {
+		return ""
 	}
-	if v, ok := d.GetOkExists("rpm_limit"); ok {
-		keyData["rpm_limit"] = v.(int)
+	return t.UTC().Format(time.RFC3339)
+}
+
+// keyMapAttribute returns a key map argument, which holds string values only.
+func keyMapAttribute(field string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Map{
+			mapvalidator.ConflictsWith(path.MatchRoot(field + "_json")),
+		},
 	}
-	if v, ok := d.GetOkExists("budget_duration"); ok {
-		keyData["budget_duration"] = v.(string)
+}
+
+// keyJSONAttribute returns the JSON-encoded variant of a key map argument, for
+// values that hold booleans, numbers or nested objects.
+func keyJSONAttribute(field string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		// Computed lets the plan keep the state value when only the formatting changes
+		Computed: true,
+		Validators: []validator.String{
+			keyJSONValidator{},
+			stringvalidator.ConflictsWith(path.MatchRoot(field)),
+		},
+		PlanModifiers: []planmodifier.String{
+			keyJSONPlanModifier{},
+		},
+		Description: fmt.Sprintf("JSON-encoded %s, use instead of %s for non-string or nested values", field, field),
 	}
-	if v, ok := d.GetOkExists("allowed_cache_controls"); ok {
-		cacheControls := expandStringList(v.([]interface{}))
-		if len(cacheControls) > 0 {
-			keyData["allowed_cache_controls"] = cacheControls
-		}
+}
+
+// keyDurationValidator validates duration strings at plan time.
+func keyDurationValidator() validator.String {
+	return stringvalidator.RegexMatches(keyDurationPattern, "must be a number followed by s, m, h, d, w or mo (e.g. 30d, 1h)")
+}
+
+// keyJSONValidator checks that a *_json argument holds a JSON object.
+type keyJSONValidator struct{}
+
+func (v keyJSONValidator) Description(ctx context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v keyJSONValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v keyJSONValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
 	}
-	if v, ok := d.GetOkExists("soft_budget"); ok {
-		keyData["soft_budget"] = v.(float64)
+
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON", fmt.Sprintf("%s must be a JSON object: %s", req.Path, err))
 	}
-	if v, ok := d.GetOkExists("key_alias"); ok {
-		keyData["key_alias"] = v.(string)
+}
+
+// keyJSONPlanModifier keeps the state value of a *_json argument when the
+// configuration decodes to the same value, so that differences in formatting or
+// key order do not produce a diff.
+type keyJSONPlanModifier struct{}
+
+func (m keyJSONPlanModifier) Description(ctx context.Context) string {
+	return "keeps the state value when the JSON decodes to the same value"
+}
+
+func (m keyJSONPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m keyJSONPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsUnknown() {
+		return
 	}
-	if v, ok := d.GetOkExists("duration"); ok {
-		keyData["duration"] = v.(string)
+	// A removed argument is planned as removed rather than computed
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
 	}
-	if v, ok := d.GetOkExists("aliases"); ok {
-		keyData["aliases"] = v.(map[string]interface{})
+	if !req.StateValue.IsNull() && !req.StateValue.IsUnknown() && jsonEqual(req.ConfigValue.ValueString(), req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+		return
 	}
-	if v, ok := d.GetOkExists("config"); ok {
-		keyData["config"] = v.(map[string]interface{})
+	resp.PlanValue = req.ConfigValue
+}
+
+// jsonEqual reports whether two JSON documents decode to the same value.
+func jsonEqual(a, b string) bool {
+	var va, vb interface{}
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false
 	}
-	if v, ok := d.GetOkExists("permissions"); ok {
-		keyData["permissions"] = v.(map[string]interface{})
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return false
 	}
-	if v, ok := d.GetOkExists("model_max_budget"); ok {
-		keyData["model_max_budget"] = v.(map[string]interface{})
+	return reflect.DeepEqual(va, vb)
+}
+
+// expandKeyJSONField returns a key map argument from either its map or its
+// JSON-encoded form. It returns false when neither is set.
+func expandKeyJSONField(value types.Map, encoded types.String) (map[string]interface{}, bool) {
+	if !encoded.IsNull() && !encoded.IsUnknown() {
+		// The value has been validated at plan time
+		var result map[string]interface{}
+		if err := json.Unmarshal([]byte(encoded.ValueString()), &result); err != nil {
+			log.Printf("[WARN] Error parsing JSON-encoded key field: %s", err)
+		}
+		return result, true
+	}
+	if !value.IsNull() && !value.IsUnknown() {
+		result := make(map[string]interface{}, len(value.Elements()))
+		for k, v := range value.Elements() {
+			if s, ok := v.(types.String); ok {
+				result[k] = s.ValueString()
+			}
+		}
+		return result, true
 	}
-	if v, ok := d.GetOkExists("model_rpm_limit"); ok {
-		keyData["model_rpm_limit"] = v.(map[string]interface{})
+	return nil, false
+}
+
+// putKeyJSONField adds a key map argument to an API request. A removed argument is sent empty.
+func putKeyJSONField(ctx context.Context, data map[string]interface{}, field string, value types.Map, encoded types.String, priorValue types.Map, priorEncoded types.String) {
+	if v, ok := expandKeyJSONField(value, encoded); ok {
+		data[field] = v
+		return
 	}
-	if v, ok := d.GetOkExists("model_tpm_limit"); ok {
-		keyData["model_tpm_limit"] = v.(map[string]interface{})
+	if !priorValue.IsNull() || !priorEncoded.IsNull() {
+		data[field] = map[string]interface{}{}
 	}
-	if v, ok := d.GetOkExists("guardrails"); ok {
-		guardrails := expandStringList(v.([]interface{}))
-		if len(guardrails) > 0 {
-			keyData["guardrails"] = guardrails
+}
+
+// flattenKeyJSONField returns a key map attribute returned by the API in the
+// form used by the configuration. The JSON form keeps its state value as long as
+// it decodes to the same value, so that formatting does not cause a diff.
+func flattenKeyJSONField(value map[string]interface{}, currentEncoded types.String) (types.Map, types.String) {
+	if !currentEncoded.IsNull() {
+		var current map[string]interface{}
+		if err := json.Unmarshal([]byte(currentEncoded.ValueString()), &current); err == nil && reflect.DeepEqual(current, value) {
+			return types.MapNull(types.StringType), currentEncoded
 		}
-	}
-	if v, ok := d.GetOkExists("blocked"); ok {
-		keyData["blocked"] = v.(bool)
-	}
-	if v, ok := d.GetOkExists("tags"); ok {
-		tags := expandStringList(v.([]interface{}))
-		if len(tags) > 0 {
-			keyData["tags"] = tags
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			log.Printf("[WARN] Error encoding key field: %s", err)
+			return types.MapNull(types.StringType), currentEncoded
 		}
+		return types.MapNull(types.StringType), types.StringValue(string(encoded))
 	}
 
-	return keyData
-}
-
-func setKeyResourceData(d *schema.ResourceData, key *Key) error {
-	fields := map[string]interface{}{
-		"key":                    key.Key,
-		"models":                 key.Models,
-		"spend":                  key.Spend,
-		"max_budget":             key.MaxBudget,
-		"user_id":                key.UserID,
-		"team_id":                key.TeamID,
-		"max_parallel_requests":  key.MaxParallelRequests,
-		"metadata":               key.Metadata,
-		"tpm_limit":              key.TPMLimit,
-		"rpm_limit":              key.RPMLimit,
-		"budget_duration":        key.BudgetDuration,
-		"allowed_cache_controls": key.AllowedCacheControls,
-		"soft_budget":            key.SoftBudget,
-		"key_alias":              key.KeyAlias,
-		"duration":               key.Duration,
-		"aliases":                key.Aliases,
-		"config":                 key.Config,
-		"permissions":            key.Permissions,
-		"model_max_budget":       key.ModelMaxBudget,
-		"model_rpm_limit":        key.ModelRPMLimit,
-		"model_tpm_limit":        key.ModelTPMLimit,
-		"guardrails":             key.Guardrails,
-		"blocked":                key.Blocked,
-		"tags":                   key.Tags,
-	}
-
-	for field, value := range fields {
-		if err := d.Set(field, value); err != nil {
-			log.Printf("[WARN] Error setting %s: %s", field, err)
-			return fmt.Errorf("error setting %s: %s", field, err)
+	// The map form only holds strings, other values are stored JSON-encoded
+	flattened := make(map[string]attr.Value, len(value))
+	for k, v := range value {
+		if str, ok := v.(string); ok {
+			flattened[k] = types.StringValue(str)
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			log.Printf("[WARN] Error encoding key field %s: %s", k, err)
+			continue
 		}
+		flattened[k] = types.StringValue(string(encoded))
 	}
-
-	return nil
+	return types.MapValueMust(types.StringType, flattened), types.StringNull()
 }
 
 func expandStringList(list []interface{}) []string {
@@ -137,62 +234,3 @@ func expandStringList(list []interface{}) []string {
 	}
 	return result
 }
-
-func mapToKey(data map[string]interface{}) *Key {
-	key := &Key{}
-	for k, v := range data {
-		switch k {
-		case "key":
-			key.Key = v.(string)
-		case "models":
-			key.Models = v.([]string)
-		case "max_budget":
-			key.MaxBudget = v.(float64)
-		case "user_id":
-			key.UserID = v.(string)
-		case "team_id":
-			key.TeamID = v.(string)
-		case "max_parallel_requests":
-			key.MaxParallelRequests = v.(int)
-		case "metadata":
-			key.Metadata = v.(map[string]interface{})
-		case "tpm_limit":
-			key.TPMLimit = v.(int)
-		case "rpm_limit":
-			key.RPMLimit = v.(int)
-		case "budget_duration":
-			key.BudgetDuration = v.(string)
-		case "allowed_cache_controls":
-			key.AllowedCacheControls = v.([]string)
-		case "soft_budget":
-			key.SoftBudget = v.(float64)
-		case "key_alias":
-			key.KeyAlias = v.(string)
-		case "duration":
-			key.Duration = v.(string)
-		case "aliases":
-			key.Aliases = v.(map[string]interface{})
-		case "config":
-			key.Config = v.(map[string]interface{})
-		case "permissions":
-			key.Permissions = v.(map[string]interface{})
-		case "model_max_budget":
-			key.ModelMaxBudget = v.(map[string]interface{})
-		case "model_rpm_limit":
-			key.ModelRPMLimit = v.(map[string]interface{})
-		case "model_tpm_limit":
-			key.ModelTPMLimit = v.(map[string]interface{})
-		case "guardrails":
-			key.Guardrails = v.([]string)
-		case "blocked":
-			key.Blocked = v.(bool)
-		case "tags":
-			key.Tags = v.([]string)
-		}
-	}
-	return key
-}
-
-func buildKeyForCreation(data map[string]interface{}) *Key {
-	return mapToKey(data)
-}
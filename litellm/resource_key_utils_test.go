@@ -0,0 +1,133 @@
+package litellm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseKeyExpires(t *testing.T) {
+	for _, tc := range []struct {
+		expires string
+		want    time.Time
+		wantErr bool
+	}{
+		{"", time.Time{}, false},
+		{"2025-01-02T03:04:05Z", time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), false},
+		{"2025-01-02T05:04:05+02:00", time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), false},
+		// LiteLLM returns timestamps without timezone, which are UTC
+		{"2025-01-02T03:04:05.123456", time.Date(2025, 1, 2, 3, 4, 5, 123456000, time.UTC), false},
+		{"2025-01-02T03:04:05", time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), false},
+		{"tomorrow", time.Time{}, true},
+	} {
+		got, err := parseKeyExpires(tc.expires)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%q: err = %v, want error %t", tc.expires, err, tc.wantErr)
+			continue
+		}
+		if !got.Equal(tc.want) {
+			t.Errorf("%q: got %s, want %s", tc.expires, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeKeyExpires(t *testing.T) {
+	for expires, want := range map[string]string{
+		"":                           "",
+		"2025-01-02T03:04:05.123456": "2025-01-02T03:04:05Z",
+		"2025-01-02T05:04:05+02:00":  "2025-01-02T03:04:05Z",
+		// Unparseable values are kept as returned
+		"tomorrow": "tomorrow",
+	} {
+		if got := normalizeKeyExpires(expires); got != want {
+			t.Errorf("%q: got %q, want %q", expires, got, want)
+		}
+	}
+}
+
+func TestValidateKeyDuration(t *testing.T) {
+	for _, valid := range []string{"", "30s", "15m", "1h", "30d", "2w", "1mo"} {
+		if _, errs := validateKeyDuration(valid, "duration"); len(errs) > 0 {
+			t.Errorf("%q: unexpected errors %v", valid, errs)
+		}
+	}
+	for _, invalid := range []string{"30", "d", "1.5h", "30 days", "-1d", "1y"} {
+		if _, errs := validateKeyDuration(invalid, "duration"); len(errs) == 0 {
+			t.Errorf("%q: expected an error", invalid)
+		}
+	}
+
+	// The framework validator accepts the same values
+	ctx := context.Background()
+	for value, wantErr := range map[string]bool{"30d": false, "1mo": false, "30": true, "1y": true} {
+		req := validator.StringRequest{Path: path.Root("duration"), ConfigValue: types.StringValue(value)}
+		resp := &validator.StringResponse{}
+		keyDurationValidator().ValidateString(ctx, req, resp)
+		if resp.Diagnostics.HasError() != wantErr {
+			t.Errorf("%q: error = %v, want %t", value, resp.Diagnostics, wantErr)
+		}
+	}
+}
+
+func TestKeyJSONField(t *testing.T) {
+	encoded := types.StringValue(`{"enabled": true, "limit": 10}`)
+	value, ok := expandKeyJSONField(types.MapNull(types.StringType), encoded)
+	if !ok || value["enabled"] != true || value["limit"] != 10.0 {
+		t.Errorf("got %#v, %t", value, ok)
+	}
+
+	// The JSON form keeps its formatting while it decodes to the same value
+	m, s := flattenKeyJSONField(map[string]interface{}{"enabled": true, "limit": 10.0}, encoded)
+	if !m.IsNull() || !s.Equal(encoded) {
+		t.Errorf("got %s, %s", m, s)
+	}
+	_, s = flattenKeyJSONField(map[string]interface{}{"limit": 20.0}, encoded)
+	if s.ValueString() != `{"limit":20}` {
+		t.Errorf("got %s", s)
+	}
+
+	// Without a JSON form, the map form is used
+	m, s = flattenKeyJSONField(map[string]interface{}{"team": "ml"}, types.StringNull())
+	if !s.IsNull() || !m.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("ml")})) {
+		t.Errorf("got %s, %s", m, s)
+	}
+
+	if _, ok := expandKeyJSONField(types.MapNull(types.StringType), types.StringNull()); ok {
+		t.Error("unset field expanded")
+	}
+}
+
+func TestKeyJSONPlanModifier(t *testing.T) {
+	ctx := context.Background()
+	state := types.StringValue(`{"enabled": true, "limit": 10}`)
+
+	for _, tc := range []struct {
+		name   string
+		config types.String
+		state  types.String
+		want   types.String
+	}{
+		{"reformatted", types.StringValue(`{"limit":10,"enabled":true}`), state, state},
+		{"changed", types.StringValue(`{"enabled": false, "limit": 10}`), state, types.StringValue(`{"enabled": false, "limit": 10}`)},
+		{"created", types.StringValue(`{"limit":10}`), types.StringNull(), types.StringValue(`{"limit":10}`)},
+		{"removed", types.StringNull(), state, types.StringNull()},
+		{"unknown", types.StringUnknown(), state, types.StringUnknown()},
+	} {
+		req := planmodifier.StringRequest{Path: path.Root("config_json"), ConfigValue: tc.config, StateValue: tc.state, PlanValue: tc.config}
+		if tc.config.IsNull() {
+			// Computed attributes removed from the configuration are planned as unknown
+			req.PlanValue = types.StringUnknown()
+		}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+		keyJSONPlanModifier{}.PlanModifyString(ctx, req, resp)
+		if !resp.PlanValue.Equal(tc.want) {
+			t.Errorf("%s: got %s, want %s", tc.name, resp.PlanValue, tc.want)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointMCPAccessGroups = "/v1/mcp/access_groups"
+)
+
+// resourceLiteLLMMCPAccessGroup declares an MCP access group. The proxy has no
+// endpoint to create groups, a group exists once an MCP server lists it in
+// mcp_access_groups, so the resource only validates and tracks the name.
+func resourceLiteLLMMCPAccessGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiteLLMMCPAccessGroupCreate,
+		ReadContext:   resourceLiteLLMMCPAccessGroupRead,
+		DeleteContext: resourceLiteLLMMCPAccessGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the MCP access group",
+			},
+			"in_use": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether at least one MCP server belongs to the group",
+			},
+		},
+	}
+}
+
+func resourceLiteLLMMCPAccessGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	d.SetId(name)
+	log.Printf("[INFO] MCP access group %s declared", name)
+
+	return resourceLiteLLMMCPAccessGroupRead(ctx, d, m)
+}
+
+func resourceLiteLLMMCPAccessGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	groups, err := listMCPAccessGroups(client)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read MCP access groups: %w", err))
+	}
+
+	// A group without servers is not listed by the proxy, it is kept in state
+	// until a server is added to it
+	d.Set("name", d.Id())
+	d.Set("in_use", groups[d.Id()])
+
+	return nil
+}
+
+func resourceLiteLLMMCPAccessGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Removing the group from its servers is done through litellm_mcp_server
+	log.Printf("[INFO] MCP access group %s removed from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func dataSourceLiteLLMMCPAccessGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLiteLLMMCPAccessGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the MCP access group",
+			},
+		},
+	}
+}
+
+func dataSourceLiteLLMMCPAccessGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+	name := d.Get("name").(string)
+
+	groups, err := listMCPAccessGroups(client)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read MCP access groups: %w", err))
+	}
+
+	if !groups[name] {
+		return diag.FromErr(fmt.Errorf("MCP access group '%s' not found, available groups: %v", name, sortedKeys(groups)))
+	}
+
+	d.SetId(name)
+	return nil
+}
+
+// listMCPAccessGroups returns the MCP access groups known to the proxy.
+func listMCPAccessGroups(client *Client) (map[string]bool, error) {
+	resp, err := MakeRequest(client, "GET", endpointMCPAccessGroups, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "listing MCP access groups"); err != nil {
+		return nil, err
+	}
+
+	var groupsResp MCPAccessGroupsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&groupsResp); err != nil {
+		return nil, fmt.Errorf("error decoding MCP access groups response: %w", err)
+	}
+
+	groups := make(map[string]bool, len(groupsResp.AccessGroups))
+	for _, group := range groupsResp.AccessGroups {
+		groups[group] = true
+	}
+	return groups, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -7,10 +7,15 @@ import (
 
 func resourceLiteLLMMCPServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLiteLLMMCPServerCreate,
-		Read:   resourceLiteLLMMCPServerRead,
-		Update: resourceLiteLLMMCPServerUpdate,
-		Delete: resourceLiteLLMMCPServerDelete,
+		CreateContext: resourceLiteLLMMCPServerCreate,
+		ReadContext:   resourceLiteLLMMCPServerRead,
+		UpdateContext: resourceLiteLLMMCPServerUpdate,
+		DeleteContext: resourceLiteLLMMCPServerDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"server_name": {
@@ -56,9 +61,68 @@ func resourceLiteLLMMCPServer() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					"none",
 					"bearer",
+					"bearer_token",
 					"basic",
+					"api_key",
+					"oauth2",
 				}, false),
-				Description: "Authentication type (none, bearer, basic)",
+				Description: "Authentication type (none, bearer, bearer_token, basic, api_key, oauth2)",
+			},
+			"authentication_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Token, API key or base64-encoded user:password used to authenticate to the MCP server, depending on auth_type",
+			},
+			"oauth2": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "OAuth2 client credentials used when auth_type is oauth2",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "OAuth2 client ID",
+						},
+						"client_secret": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "OAuth2 client secret",
+						},
+						"token_url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "OAuth2 token endpoint",
+						},
+						"authorization_url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "OAuth2 authorization endpoint",
+						},
+						"scopes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "OAuth2 scopes to request",
+						},
+					},
+				},
+			},
+			"static_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Headers sent with every request to the MCP server",
+			},
+			"extra_headers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of client request headers forwarded to the MCP server",
 			},
 			"mcp_access_groups": {
 				Type:        schema.TypeList,
@@ -129,6 +193,19 @@ func resourceLiteLLMMCPServer() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_healthy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Test the connection before creating the server and wait for it to report healthy afterwards",
+			},
+			"health_check_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "2m",
+				ValidateFunc: validateDurationString,
+				Description:  "How long to wait for the server to become healthy when wait_for_healthy is set",
+			},
 			// Read-only computed fields
 			"server_id": {
 				Type:        schema.TypeString,
@@ -1,10 +1,12 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,94 +17,70 @@ const (
 	endpointMCPServerDelete = "/v1/mcp/server"
 )
 
-// Helper function to convert schema data to MCPServerRequest
-func buildMCPServerRequest(d *schema.ResourceData) *MCPServerRequest {
-	req := &MCPServerRequest{
-		ServerName:  d.Get("server_name").(string),
-		URL:         d.Get("url").(string),
-		Transport:   d.Get("transport").(string),
-		SpecVersion: d.Get("spec_version").(string),
-		AuthType:    d.Get("auth_type").(string),
+// buildMCPServerRequest returns the body of an MCP server create or update
+// request. Arguments removed from the configuration are cleared.
+func buildMCPServerRequest(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"server_name":  d.Get("server_name").(string),
+		"url":          d.Get("url").(string),
+		"transport":    d.Get("transport").(string),
+		"spec_version": d.Get("spec_version").(string),
+		"auth_type":    d.Get("auth_type").(string),
 	}
-
-	// Set optional fields
-	if alias, ok := d.GetOk("alias"); ok {
-		req.Alias = alias.(string)
-	}
-	if description, ok := d.GetOk("description"); ok {
-		req.Description = description.(string)
-	}
-	if command, ok := d.GetOk("command"); ok {
-		req.Command = command.(string)
+	putFields(d, data, "alias", "description", "command", "mcp_access_groups", "args", "env", "static_headers", "extra_headers")
+
+	// Handle authentication, secrets are only sent and never read back
+	credentials := map[string]interface{}{}
+	switch {
+	case fieldConfigured(d, "authentication_token"):
+		credentials["auth_value"] = d.Get("authentication_token").(string)
+	case fieldRemoved(d, "authentication_token"):
+		credentials["auth_value"] = nil
 	}
-
-	// Handle access groups
-	if accessGroups, ok := d.GetOk("mcp_access_groups"); ok {
-		accessGroupsList := accessGroups.([]interface{})
-		req.MCPAccessGroups = make([]string, len(accessGroupsList))
-		for i, group := range accessGroupsList {
-			req.MCPAccessGroups[i] = group.(string)
-		}
+	if oauth2List := d.Get("oauth2").([]interface{}); len(oauth2List) > 0 && oauth2List[0] != nil {
+		oauth2 := oauth2List[0].(map[string]interface{})
+		credentials["client_id"] = oauth2["client_id"].(string)
+		credentials["client_secret"] = oauth2["client_secret"].(string)
+		credentials["scopes"] = expandStringList(oauth2["scopes"].([]interface{}))
+		data["token_url"] = oauth2["token_url"].(string)
+		data["authorization_url"] = oauth2["authorization_url"].(string)
+	} else if blockRemoved(d, "oauth2") {
+		credentials["client_id"] = nil
+		credentials["client_secret"] = nil
+		credentials["scopes"] = []string{}
+		data["token_url"] = nil
+		data["authorization_url"] = nil
 	}
-
-	// Handle args
-	if args, ok := d.GetOk("args"); ok {
-		argsList := args.([]interface{})
-		req.Args = make([]string, len(argsList))
-		for i, arg := range argsList {
-			req.Args[i] = arg.(string)
-		}
+	if len(credentials) > 0 {
+		data["credentials"] = credentials
 	}
 
-	// Handle env
-	if env, ok := d.GetOk("env"); ok {
-		envMap := env.(map[string]interface{})
-		req.Env = make(map[string]string)
-		for k, v := range envMap {
-			req.Env[k] = v.(string)
+	// Handle mcp_info
+	if mcpInfos := d.Get("mcp_info").([]interface{}); len(mcpInfos) > 0 && mcpInfos[0] != nil {
+		mcpInfoMap := mcpInfos[0].(map[string]interface{})
+		mcpInfo := &MCPInfo{
+			ServerName:  mcpInfoMap["server_name"].(string),
+			Description: mcpInfoMap["description"].(string),
+			LogoURL:     mcpInfoMap["logo_url"].(string),
 		}
-	}
 
-	// Handle mcp_info
-	if mcpInfoList, ok := d.GetOk("mcp_info"); ok {
-		mcpInfos := mcpInfoList.([]interface{})
-		if len(mcpInfos) > 0 {
-			mcpInfoMap := mcpInfos[0].(map[string]interface{})
-			req.MCPInfo = &MCPInfo{}
-
-			if serverName, ok := mcpInfoMap["server_name"]; ok {
-				req.MCPInfo.ServerName = serverName.(string)
-			}
-			if description, ok := mcpInfoMap["description"]; ok {
-				req.MCPInfo.Description = description.(string)
-			}
-			if logoURL, ok := mcpInfoMap["logo_url"]; ok {
-				req.MCPInfo.LogoURL = logoURL.(string)
+		// Handle cost info
+		if costInfos := mcpInfoMap["mcp_server_cost_info"].([]interface{}); len(costInfos) > 0 && costInfos[0] != nil {
+			costInfoMap := costInfos[0].(map[string]interface{})
+			mcpInfo.MCPServerCostInfo = &MCPServerCostInfo{
+				DefaultCostPerQuery:    costInfoMap["default_cost_per_query"].(float64),
+				ToolNameToCostPerQuery: make(map[string]float64),
 			}
-
-			// Handle cost info
-			if costInfoList, ok := mcpInfoMap["mcp_server_cost_info"]; ok {
-				costInfos := costInfoList.([]interface{})
-				if len(costInfos) > 0 {
-					costInfoMap := costInfos[0].(map[string]interface{})
-					req.MCPInfo.MCPServerCostInfo = &MCPServerCostInfo{}
-
-					if defaultCost, ok := costInfoMap["default_cost_per_query"]; ok {
-						req.MCPInfo.MCPServerCostInfo.DefaultCostPerQuery = defaultCost.(float64)
-					}
-					if toolCosts, ok := costInfoMap["tool_name_to_cost_per_query"]; ok {
-						toolCostMap := toolCosts.(map[string]interface{})
-						req.MCPInfo.MCPServerCostInfo.ToolNameToCostPerQuery = make(map[string]float64)
-						for k, v := range toolCostMap {
-							req.MCPInfo.MCPServerCostInfo.ToolNameToCostPerQuery[k] = v.(float64)
-						}
-					}
-				}
+			for k, v := range costInfoMap["tool_name_to_cost_per_query"].(map[string]interface{}) {
+				mcpInfo.MCPServerCostInfo.ToolNameToCostPerQuery[k] = v.(float64)
 			}
 		}
+		data["mcp_info"] = mcpInfo
+	} else if blockRemoved(d, "mcp_info") {
+		data["mcp_info"] = nil
 	}
 
-	return req
+	return data
 }
 
 // Helper function to update schema data from MCPServerResponse
@@ -139,6 +117,20 @@ func updateSchemaFromResponse(d *schema.ResourceData, resp *MCPServerResponse) e
 		d.Set("env", resp.Env)
 	}
 
+	// Set extra headers, static headers and credentials are kept from the configuration
+	if resp.ExtraHeaders != nil {
+		d.Set("extra_headers", resp.ExtraHeaders)
+	}
+	if oauth2List, ok := d.GetOk("oauth2"); ok && (resp.TokenURL != "" || resp.AuthorizationURL != "") {
+		oauth2s := oauth2List.([]interface{})
+		if len(oauth2s) > 0 && oauth2s[0] != nil {
+			oauth2 := oauth2s[0].(map[string]interface{})
+			oauth2["token_url"] = resp.TokenURL
+			oauth2["authorization_url"] = resp.AuthorizationURL
+			d.Set("oauth2", []interface{}{oauth2})
+		}
+	}
+
 	// Set mcp_info
 	if resp.MCPInfo != nil {
 		mcpInfoList := make([]map[string]interface{}, 1)
@@ -168,48 +160,63 @@ func updateSchemaFromResponse(d *schema.ResourceData, resp *MCPServerResponse) e
 	return nil
 }
 
-func resourceLiteLLMMCPServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceLiteLLMMCPServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client, ok := m.(*Client)
 	if !ok {
-		return fmt.Errorf("invalid type assertion for client")
+		return diag.FromErr(fmt.Errorf("invalid type assertion for client"))
 	}
+	client = client.withContext(ctx)
 
 	req := buildMCPServerRequest(d)
 
+	if err := checkMCPServerHealthOnCreate(d, client, req); err != nil {
+		return diag.FromErr(err)
+	}
+
 	resp, err := MakeRequest(client, "POST", endpointMCPServerCreate, req)
 	if err != nil {
-		return fmt.Errorf("failed to create MCP server: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to create MCP server: %w", err))
 	}
 	defer resp.Body.Close()
 
 	var mcpResp MCPServerResponse
 	if err := handleMCPAPIResponse(resp, &mcpResp, client); err != nil {
-		return fmt.Errorf("failed to create MCP server: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to create MCP server: %w", err))
 	}
 
 	d.SetId(mcpResp.ServerID)
 
 	// Update the state with the response data
 	if err := updateSchemaFromResponse(d, &mcpResp); err != nil {
-		return fmt.Errorf("failed to update state after create: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update state after create: %w", err))
 	}
 
 	log.Printf("[INFO] MCP server created with ID %s", mcpResp.ServerID)
+
+	// The ID is already set, so an unhealthy server is tainted and replaced on the next apply
+	if err := waitForMCPServerHealthyAfterCreate(d, client); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get("wait_for_healthy").(bool) {
+		return resourceLiteLLMMCPServerRead(ctx, d, m)
+	}
+
 	return nil
 }
 
-func resourceLiteLLMMCPServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceLiteLLMMCPServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client, ok := m.(*Client)
 	if !ok {
-		return fmt.Errorf("invalid type assertion for client")
+		return diag.FromErr(fmt.Errorf("invalid type assertion for client"))
 	}
+	client = client.withContext(ctx)
 
 	serverID := d.Id()
 	endpoint := fmt.Sprintf("%s/%s", endpointMCPServerRead, serverID)
 
 	resp, err := MakeRequest(client, "GET", endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("failed to read MCP server: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read MCP server: %w", err))
 	}
 	defer resp.Body.Close()
 
@@ -219,64 +226,66 @@ func resourceLiteLLMMCPServerRead(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to read MCP server: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read MCP server: %w", err))
 	}
 
 	// Update the state with the response data
 	if err := updateSchemaFromResponse(d, &mcpResp); err != nil {
-		return fmt.Errorf("failed to update state after read: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update state after read: %w", err))
 	}
 
 	return nil
 }
 
-func resourceLiteLLMMCPServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceLiteLLMMCPServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client, ok := m.(*Client)
 	if !ok {
-		return fmt.Errorf("invalid type assertion for client")
+		return diag.FromErr(fmt.Errorf("invalid type assertion for client"))
 	}
+	client = client.withContext(ctx)
 
 	req := buildMCPServerRequest(d)
-	req.ServerID = d.Id() // Ensure we include the server ID for updates
+	req["server_id"] = d.Id()
 
 	resp, err := MakeRequest(client, "PUT", endpointMCPServerUpdate, req)
 	if err != nil {
-		return fmt.Errorf("failed to update MCP server: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update MCP server: %w", err))
 	}
 	defer resp.Body.Close()
 
 	var mcpResp MCPServerResponse
 	if err := handleMCPAPIResponse(resp, &mcpResp, client); err != nil {
-		return fmt.Errorf("failed to update MCP server: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update MCP server: %w", err))
 	}
 
 	// Update the state with the response data
 	if err := updateSchemaFromResponse(d, &mcpResp); err != nil {
-		return fmt.Errorf("failed to update state after update: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update state after update: %w", err))
 	}
 
 	log.Printf("[INFO] MCP server updated with ID %s", mcpResp.ServerID)
 	return nil
 }
 
-func resourceLiteLLMMCPServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceLiteLLMMCPServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client, ok := m.(*Client)
 	if !ok {
-		return fmt.Errorf("invalid type assertion for client")
+		return diag.FromErr(fmt.Errorf("invalid type assertion for client"))
 	}
+	client = client.withContext(ctx)
 
 	serverID := d.Id()
 	endpoint := fmt.Sprintf("%s/%s", endpointMCPServerDelete, serverID)
 
 	resp, err := MakeRequest(client, "DELETE", endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("failed to delete MCP server: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to delete MCP server: %w", err))
 	}
 	defer resp.Body.Close()
 
 	// For delete operations, we expect a simple string response
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("failed to delete MCP server: unexpected status code %d", resp.StatusCode)
+		return diag.FromErr(fmt.Errorf("failed to delete MCP server: unexpected status code %d", resp.StatusCode))
 	}
 
 	d.SetId("")
@@ -285,7 +294,7 @@ func resourceLiteLLMMCPServerDelete(d *schema.ResourceData, m interface{}) error
 }
 
 // retryMCPServerRead attempts to read an MCP server with exponential backoff
-func retryMCPServerRead(d *schema.ResourceData, m interface{}, maxRetries int) error {
+func retryMCPServerRead(ctx context.Context, d *schema.ResourceData, m interface{}, maxRetries int) error {
 	var err error
 	delay := 1 * time.Second
 	maxDelay := 10 * time.Second
@@ -293,11 +302,12 @@ func retryMCPServerRead(d *schema.ResourceData, m interface{}, maxRetries int) e
 	for i := 0; i < maxRetries; i++ {
 		log.Printf("[INFO] Attempting to read MCP server (attempt %d/%d)", i+1, maxRetries)
 
-		err = resourceLiteLLMMCPServerRead(d, m)
-		if err == nil {
+		diags := resourceLiteLLMMCPServerRead(ctx, d, m)
+		if !diags.HasError() {
 			log.Printf("[INFO] Successfully read MCP server after %d attempts", i+1)
 			return nil
 		}
+		err = fmt.Errorf("%s", diags[0].Summary)
 
 		// Check if this is a "server not found" error
 		if err.Error() != "failed to read MCP server: mcp_server_not_found" {
@@ -307,7 +317,9 @@ func retryMCPServerRead(d *schema.ResourceData, m interface{}, maxRetries int) e
 
 		if i < maxRetries-1 {
 			log.Printf("[INFO] MCP server not found yet, retrying in %v...", delay)
-			time.Sleep(delay)
+			if err := sleepWithContext(ctx, delay); err != nil {
+				return fmt.Errorf("stopped waiting for MCP server %s: %w", d.Id(), err)
+			}
 
 			// Exponential backoff with a maximum delay
 			delay *= 2
@@ -0,0 +1,145 @@
+package litellm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointMCPTestConnection = "/mcp-rest/test/connection"
+
+	mcpServerStatusHealthy = "healthy"
+)
+
+// mcpHealthPollInterval is the delay between two health checks while waiting for a server.
+var mcpHealthPollInterval = 5 * time.Second
+
+// MCPHealthResponse represents the result of an MCP connection test or health check.
+type MCPHealthResponse struct {
+	ServerID         string `json:"server_id,omitempty"`
+	Status           string `json:"status"`
+	Message          string `json:"message,omitempty"`
+	Error            string `json:"error,omitempty"`
+	HealthCheckError string `json:"health_check_error,omitempty"`
+	LastHealthCheck  string `json:"last_health_check,omitempty"`
+}
+
+// errorMessage returns the most specific error reported in the response.
+func (r *MCPHealthResponse) errorMessage() string {
+	for _, msg := range []string{r.HealthCheckError, r.Error, r.Message} {
+		if msg != "" {
+			return msg
+		}
+	}
+	return fmt.Sprintf("status %q", r.Status)
+}
+
+// validateDurationString validates Go duration strings such as 90s or 5m.
+func validateDurationString(v interface{}, k string) (warnings []string, errs []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a duration such as 90s or 5m: %v", k, err))
+	}
+	return warnings, errs
+}
+
+// testMCPServerConnection checks that the proxy can reach an MCP server before it is registered.
+func testMCPServerConnection(client *Client, req map[string]interface{}) error {
+	resp, err := MakeRequest(client, "POST", endpointMCPTestConnection, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed: Status: %s, Response: %s", resp.Status, client.redactSensitiveData(string(body)))
+	}
+
+	var testResp MCPHealthResponse
+	if err := json.Unmarshal(body, &testResp); err != nil {
+		return fmt.Errorf("failed to parse response: %v", err)
+	}
+
+	switch strings.ToLower(testResp.Status) {
+	case "error", "failed", "unhealthy":
+		return fmt.Errorf("%s", testResp.errorMessage())
+	}
+	return nil
+}
+
+// getMCPServerHealth runs a health check on a registered MCP server.
+func getMCPServerHealth(client *Client, serverID string) (*MCPHealthResponse, error) {
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s/%s/health", endpointMCPServerRead, serverID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var healthResp MCPHealthResponse
+	if err := handleMCPAPIResponse(resp, &healthResp, client); err != nil {
+		return nil, err
+	}
+	return &healthResp, nil
+}
+
+// waitForMCPServerHealthy polls the health of an MCP server until it is healthy or the timeout expires.
+func waitForMCPServerHealthy(client *Client, serverID string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	lastError := "no health check completed"
+
+	for {
+		health, err := getMCPServerHealth(client, serverID)
+		if err != nil {
+			lastError = err.Error()
+			log.Printf("[WARN] Health check of MCP server %s failed: %s", serverID, err)
+		} else if strings.ToLower(health.Status) == mcpServerStatusHealthy {
+			log.Printf("[INFO] MCP server %s is healthy", serverID)
+			return nil
+		} else {
+			lastError = health.errorMessage()
+			log.Printf("[DEBUG] MCP server %s is not healthy yet: %s", serverID, lastError)
+		}
+
+		if time.Now().Add(mcpHealthPollInterval).After(deadline) {
+			return fmt.Errorf("MCP server %s did not become healthy within %s: %s", serverID, timeout, lastError)
+		}
+		if err := sleepWithContext(client.context(), mcpHealthPollInterval); err != nil {
+			return fmt.Errorf("stopped waiting for MCP server %s to become healthy: %w", serverID, err)
+		}
+	}
+}
+
+// checkMCPServerHealthOnCreate runs the connection test before an MCP server is created.
+func checkMCPServerHealthOnCreate(d *schema.ResourceData, client *Client, req map[string]interface{}) error {
+	if !d.Get("wait_for_healthy").(bool) {
+		return nil
+	}
+
+	serverName := d.Get("server_name").(string)
+	log.Printf("[INFO] Testing connection to MCP server %s", serverName)
+	if err := testMCPServerConnection(client, req); err != nil {
+		return fmt.Errorf("connection test to MCP server %s failed: %w", serverName, err)
+	}
+	return nil
+}
+
+// waitForMCPServerHealthyAfterCreate polls the health of a newly created MCP server.
+func waitForMCPServerHealthyAfterCreate(d *schema.ResourceData, client *Client) error {
+	if !d.Get("wait_for_healthy").(bool) {
+		return nil
+	}
+
+	// The timeout has been validated at plan time
+	timeout, _ := time.ParseDuration(d.Get("health_check_timeout").(string))
+	return waitForMCPServerHealthy(client, d.Id(), timeout)
+}
@@ -0,0 +1,103 @@
+package litellm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBuildMCPServerRequestCreate(t *testing.T) {
+	data := testApplyRequest(t, resourceLiteLLMMCPServer().Schema, nil, `{
+		"server_name": "github",
+		"url": "https://mcp.example.com",
+		"transport": "http",
+		"auth_type": "oauth2",
+		"args": [],
+		"oauth2": [{"client_id": "client", "client_secret": "secret", "token_url": "https://idp.example.com/token", "scopes": ["repo"]}]
+	}`, buildMCPServerRequest)
+
+	want := map[string]interface{}{
+		"server_name":       "github",
+		"url":               "https://mcp.example.com",
+		"transport":         "http",
+		"spec_version":      "2024-11-05",
+		"auth_type":         "oauth2",
+		"args":              []interface{}{},
+		"token_url":         "https://idp.example.com/token",
+		"authorization_url": "",
+		"credentials": map[string]interface{}{
+			"client_id":     "client",
+			"client_secret": "secret",
+			"scopes":        []string{"repo"},
+		},
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("got %#v, want %#v", data, want)
+	}
+}
+
+func TestBuildMCPServerRequestRemoved(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "server-1",
+		Attributes: map[string]string{
+			"id":                     "server-1",
+			"server_name":            "github",
+			"url":                    "https://mcp.example.com",
+			"transport":              "stdio",
+			"spec_version":           "2024-11-05",
+			"auth_type":              "oauth2",
+			"description":            "GitHub tools",
+			"alias":                  "",
+			"command":                "npx",
+			"args.#":                 "1",
+			"args.0":                 "server-github",
+			"env.%":                  "1",
+			"env.GITHUB_TOKEN":       "token",
+			"mcp_access_groups.#":    "1",
+			"mcp_access_groups.0":    "dev",
+			"authentication_token":   "token",
+			"static_headers.%":       "1",
+			"static_headers.X-Org":   "acme",
+			"extra_headers.#":        "1",
+			"extra_headers.0":        "X-User",
+			"oauth2.#":               "1",
+			"oauth2.0.client_id":     "client",
+			"oauth2.0.client_secret": "secret",
+			"oauth2.0.token_url":     "https://idp.example.com/token",
+		},
+	}
+	data := testApplyRequest(t, resourceLiteLLMMCPServer().Schema, state, `{
+		"server_name": "github",
+		"url": "https://mcp.example.com",
+		"transport": "stdio",
+		"command": "npx"
+	}`, buildMCPServerRequest)
+
+	// Removed arguments are cleared: strings as null, lists and maps as empty
+	want := map[string]interface{}{
+		"server_name":       "github",
+		"url":               "https://mcp.example.com",
+		"transport":         "stdio",
+		"spec_version":      "2024-11-05",
+		"auth_type":         "none",
+		"command":           "npx",
+		"description":       nil,
+		"args":              []interface{}{},
+		"env":               map[string]interface{}{},
+		"mcp_access_groups": []interface{}{},
+		"static_headers":    map[string]interface{}{},
+		"extra_headers":     []interface{}{},
+		"token_url":         nil,
+		"authorization_url": nil,
+		"credentials": map[string]interface{}{
+			"auth_value":    nil,
+			"client_id":     nil,
+			"client_secret": nil,
+			"scopes":        []string{},
+		},
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("got %#v, want %#v", data, want)
+	}
+}
@@ -1,170 +1,296 @@
 package litellm
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &modelResource{}
+	_ resource.ResourceWithConfigure    = &modelResource{}
+	_ resource.ResourceWithImportState  = &modelResource{}
+	_ resource.ResourceWithUpgradeState = &modelResource{}
 )
 
-func resourceLiteLLMModel() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceLiteLLMModelCreate,
-		Read:   resourceLiteLLMModelRead,
-		Update: resourceLiteLLMModelUpdate,
-		Delete: resourceLiteLLMModelDelete,
+// modelResource manages litellm_model with terraform-plugin-framework, so that
+// explicit zero and false values can be told apart from unset attributes.
+type modelResource struct {
+	client *Client
+}
+
+// modelResourceModel maps the litellm_model schema.
+type modelResourceModel struct {
+	ID                             types.String   `tfsdk:"id"`
+	ModelName                      types.String   `tfsdk:"model_name"`
+	CustomLLMProvider              types.String   `tfsdk:"custom_llm_provider"`
+	TPM                            types.Int64    `tfsdk:"tpm"`
+	RPM                            types.Int64    `tfsdk:"rpm"`
+	ReasoningEffort                types.String   `tfsdk:"reasoning_effort"`
+	ThinkingEnabled                types.Bool     `tfsdk:"thinking_enabled"`
+	ThinkingBudgetTokens           types.Int64    `tfsdk:"thinking_budget_tokens"`
+	MergeReasoningContentInChoices types.Bool     `tfsdk:"merge_reasoning_content_in_choices"`
+	ModelAPIKey                    types.String   `tfsdk:"model_api_key"`
+	ModelAPIBase                   types.String   `tfsdk:"model_api_base"`
+	APIVersion                     types.String   `tfsdk:"api_version"`
+	BaseModel                      types.String   `tfsdk:"base_model"`
+	Tier                           types.String   `tfsdk:"tier"`
+	TeamID                         types.String   `tfsdk:"team_id"`
+	Mode                           types.String   `tfsdk:"mode"`
+	InputCostPerMillionTokens      types.Float64  `tfsdk:"input_cost_per_million_tokens"`
+	OutputCostPerMillionTokens     types.Float64  `tfsdk:"output_cost_per_million_tokens"`
+	InputCostPerPixel              types.Float64  `tfsdk:"input_cost_per_pixel"`
+	OutputCostPerPixel             types.Float64  `tfsdk:"output_cost_per_pixel"`
+	InputCostPerSecond             types.Float64  `tfsdk:"input_cost_per_second"`
+	OutputCostPerSecond            types.Float64  `tfsdk:"output_cost_per_second"`
+	AWSAccessKeyID                 types.String   `tfsdk:"aws_access_key_id"`
+	AWSSecretAccessKey             types.String   `tfsdk:"aws_secret_access_key"`
+	AWSRegionName                  types.String   `tfsdk:"aws_region_name"`
+	AWSSessionName                 types.String   `tfsdk:"aws_session_name"`
+	AWSRoleName                    types.String   `tfsdk:"aws_role_name"`
+	VertexProject                  types.String   `tfsdk:"vertex_project"`
+	VertexLocation                 types.String   `tfsdk:"vertex_location"`
+	VertexCredentials              types.String   `tfsdk:"vertex_credentials"`
+	AdditionalLiteLLMParams        types.Map      `tfsdk:"additional_litellm_params"`
+	Timeouts                       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewModelResource returns the litellm_model resource.
+func NewModelResource() resource.Resource {
+	return &modelResource{}
+}
+
+func (r *modelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model"
+}
+
+func (r *modelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = modelResourceSchema(ctx)
+	resp.Schema.Version = 1
+}
 
-		Schema: map[string]*schema.Schema{
-			"model_name": {
-				Type:     schema.TypeString,
+// modelResourceSchema returns the attributes of litellm_model. They are unchanged
+// from the SDK implementation (schema version 0), only their null handling differs.
+func modelResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model_name": schema.StringAttribute{
 				Required: true,
 			},
-			"custom_llm_provider": {
-				Type:     schema.TypeString,
+			"custom_llm_provider": schema.StringAttribute{
 				Required: true,
 			},
-			"tpm": {
-				Type:     schema.TypeInt,
+			"tpm": schema.Int64Attribute{
 				Optional: true,
 			},
-			"rpm": {
-				Type:     schema.TypeInt,
+			"rpm": schema.Int64Attribute{
 				Optional: true,
 			},
-			"reasoning_effort": {
-				Type:     schema.TypeString,
+			"reasoning_effort": schema.StringAttribute{
 				Optional: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"low",
-					"medium",
-					"high",
-				}, false),
+				Validators: []validator.String{
+					stringvalidator.OneOf("low", "medium", "high"),
+				},
 			},
-			"thinking_enabled": {
-				Type:     schema.TypeBool,
+			"thinking_enabled": schema.BoolAttribute{
 				Optional: true,
-				Default:  false,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
-			"thinking_budget_tokens": {
-				Type:     schema.TypeInt,
+			"thinking_budget_tokens": schema.Int64Attribute{
 				Optional: true,
-				Default:  1024,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Only include thinking_budget_tokens in the diff if thinking_enabled is true
-					return !d.Get("thinking_enabled").(bool)
-				},
+				Computed: true,
+				Default:  int64default.StaticInt64(1024),
 			},
-			"merge_reasoning_content_in_choices": {
-				Type:     schema.TypeBool,
+			"merge_reasoning_content_in_choices": schema.BoolAttribute{
 				Optional: true,
 			},
-			"model_api_key": {
-				Type:      schema.TypeString,
+			"model_api_key": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
-			"model_api_base": {
-				Type:     schema.TypeString,
+			"model_api_base": schema.StringAttribute{
 				Optional: true,
 			},
-			"api_version": {
-				Type:     schema.TypeString,
+			"api_version": schema.StringAttribute{
 				Optional: true,
 			},
-			"base_model": {
-				Type:     schema.TypeString,
+			"base_model": schema.StringAttribute{
 				Required: true,
 			},
-			"tier": {
-				Type:     schema.TypeString,
+			"tier": schema.StringAttribute{
 				Optional: true,
-				Default:  "free",
+				Computed: true,
+				Default:  stringdefault.StaticString("free"),
 			},
-			"team_id": {
-				Type:     schema.TypeString,
+			"team_id": schema.StringAttribute{
 				Optional: true,
 			},
-			"mode": {
-				Type:     schema.TypeString,
+			"mode": schema.StringAttribute{
 				Optional: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"completion",
-					"embedding",
-					"image_generation",
-					"chat",
-					"moderation",
-					"audio_transcription",
-				}, false),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"completion",
+						"embedding",
+						"image_generation",
+						"chat",
+						"moderation",
+						"audio_transcription",
+					),
+				},
 			},
-			"input_cost_per_million_tokens": {
-				Type:     schema.TypeFloat,
+			"input_cost_per_million_tokens": schema.Float64Attribute{
 				Optional: true,
 			},
-			"output_cost_per_million_tokens": {
-				Type:     schema.TypeFloat,
+			"output_cost_per_million_tokens": schema.Float64Attribute{
 				Optional: true,
 			},
-			"input_cost_per_pixel": {
-				Type:     schema.TypeFloat,
+			"input_cost_per_pixel": schema.Float64Attribute{
 				Optional: true,
 			},
-			"output_cost_per_pixel": {
-				Type:     schema.TypeFloat,
+			"output_cost_per_pixel": schema.Float64Attribute{
 				Optional: true,
 			},
-			"input_cost_per_second": {
-				Type:     schema.TypeFloat,
+			"input_cost_per_second": schema.Float64Attribute{
 				Optional: true,
 			},
-			"output_cost_per_second": {
-				Type:     schema.TypeFloat,
+			"output_cost_per_second": schema.Float64Attribute{
 				Optional: true,
 			},
-			"aws_access_key_id": {
-				Type:      schema.TypeString,
+			"aws_access_key_id": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
-			"aws_secret_access_key": {
-				Type:      schema.TypeString,
+			"aws_secret_access_key": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
-			"aws_region_name": {
-				Type:     schema.TypeString,
+			"aws_region_name": schema.StringAttribute{
 				Optional: true,
 			},
-			"aws_session_name": {
-				Type:      schema.TypeString,
+			"aws_session_name": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
-			"aws_role_name": {
-				Type:      schema.TypeString,
+			"aws_role_name": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
-			"vertex_project": {
-				Type:      schema.TypeString,
+			"vertex_project": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
-			"vertex_location": {
-				Type:      schema.TypeString,
+			"vertex_location": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
-			"vertex_credentials": {
-				Type:      schema.TypeString,
+			"vertex_credentials": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
-			"additional_litellm_params": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
+			"additional_litellm_params": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
 				Description: "Additional parameters to pass to litellm_params beyond the standard ones",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *modelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *Client, got %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *modelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// UpgradeState converts state written by the SDK implementation, which stored
+// unset attributes as "", 0 or false, so that they are null like in the configuration.
+func (r *modelResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := modelResourceSchema(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state modelResourceModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				for _, s := range []*types.String{
+					&state.ReasoningEffort, &state.ModelAPIKey, &state.ModelAPIBase, &state.APIVersion,
+					&state.TeamID, &state.Mode, &state.AWSAccessKeyID, &state.AWSSecretAccessKey,
+					&state.AWSRegionName, &state.AWSSessionName, &state.AWSRoleName,
+					&state.VertexProject, &state.VertexLocation, &state.VertexCredentials,
+				} {
+					if s.ValueString() == "" {
+						*s = types.StringNull()
+					}
+				}
+				for _, i := range []*types.Int64{&state.TPM, &state.RPM} {
+					if i.ValueInt64() == 0 {
+						*i = types.Int64Null()
+					}
+				}
+				for _, f := range []*types.Float64{
+					&state.InputCostPerMillionTokens, &state.OutputCostPerMillionTokens,
+					&state.InputCostPerPixel, &state.OutputCostPerPixel,
+					&state.InputCostPerSecond, &state.OutputCostPerSecond,
+				} {
+					if f.ValueFloat64() == 0 {
+						*f = types.Float64Null()
+					}
+				}
+				if !state.MergeReasoningContentInChoices.ValueBool() {
+					state.MergeReasoningContentInChoices = types.BoolNull()
+				}
+				if len(state.AdditionalLiteLLMParams.Elements()) == 0 {
+					state.AdditionalLiteLLMParams = types.MapNull(types.StringType)
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
 	}
 }
@@ -1,397 +1,544 @@
 package litellm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"math"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// retryModelRead attempts to read a model with exponential backoff
-func retryModelRead(d *schema.ResourceData, m interface{}, maxRetries int) error {
-	var err error
-	delay := 1 * time.Second
-	maxDelay := 10 * time.Second
-
-	for i := 0; i < maxRetries; i++ {
-		log.Printf("[INFO] Attempting to read model (attempt %d/%d)", i+1, maxRetries)
-
-		err = resourceLiteLLMModelRead(d, m)
-		if err == nil {
-			log.Printf("[INFO] Successfully read model after %d attempts", i+1)
-			return nil
-		}
-
-		// Check if this is a "model not found" error
-		if err.Error() != "failed to read model: API request failed: Status: 400 Bad Request, Response: {\"detail\":{\"error\":\"Model id = "+d.Id()+" not found on litellm proxy\"}}, Request: null" {
-			// If it's a different error, don't retry
-			return err
-		}
-
-		if i < maxRetries-1 {
-			log.Printf("[INFO] Model not found yet, retrying in %v...", delay)
-			time.Sleep(delay)
-
-			// Exponential backoff with a maximum delay
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
-		}
-	}
-
-	log.Printf("[WARN] Failed to read model after %d attempts: %v", maxRetries, err)
-	return err
-}
-
 const (
 	endpointModelNew    = "/model/new"
 	endpointModelUpdate = "/model/update"
 	endpointModelInfo   = "/model/info"
 	endpointModelDelete = "/model/delete"
+
+	// modelReadRetries is the number of attempts made to read a model right after it is written
+	modelReadRetries = 5
 )
 
-func createOrUpdateModel(d *schema.ResourceData, m interface{}, isUpdate bool) error {
-	client, ok := m.(*Client)
-	if !ok {
-		return fmt.Errorf("invalid type assertion for client")
+func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan modelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Convert cost per million tokens to cost per token
-	inputCostPerToken := d.Get("input_cost_per_million_tokens").(float64) / 1000000.0
-	outputCostPerToken := d.Get("output_cost_per_million_tokens").(float64) / 1000000.0
-
-	// Construct the model name in the format "custom_llm_provider/base_model"
-	customLLMProvider := d.Get("custom_llm_provider").(string)
-	baseModel := d.Get("base_model").(string)
-	modelName := fmt.Sprintf("%s/%s", customLLMProvider, baseModel)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	// Generate a UUID for new models
-	modelID := d.Id()
-	if !isUpdate {
-		modelID = uuid.New().String()
+	modelID, err := r.writeModel(ctx, &plan, nil, uuid.New().String())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating model", err.Error())
+		return
 	}
 
-	// Create thinking configuration if enabled
-	var thinking map[string]interface{}
-	if d.Get("thinking_enabled").(bool) {
-		thinking = map[string]interface{}{
-			"type":          "enabled",
-			"budget_tokens": d.Get("thinking_budget_tokens").(int),
-		}
+	plan.ID = types.StringValue(modelID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state modelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Build the base litellm_params as a map to allow for additional parameters
-	litellmParams := map[string]interface{}{
-		"custom_llm_provider":                customLLMProvider,
-		"model":                              modelName,
-		"input_cost_per_token":               inputCostPerToken,
-		"output_cost_per_token":              outputCostPerToken,
-		"merge_reasoning_content_in_choices": d.Get("merge_reasoning_content_in_choices").(bool),
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	// Add optional parameters only if they have values
-	if tpm := d.Get("tpm").(int); tpm > 0 {
-		litellmParams["tpm"] = tpm
+	model, err := getModel(r.client.withContext(ctx), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading model", err.Error())
+		return
 	}
-	if rpm := d.Get("rpm").(int); rpm > 0 {
-		litellmParams["rpm"] = rpm
+	if model == nil {
+		log.Printf("[WARN] Model %s not found, removing from state", state.ID.ValueString())
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if apiKey := d.Get("model_api_key").(string); apiKey != "" {
-		litellmParams["api_key"] = apiKey
+
+	// model_name is required, it is only null right after an import
+	if state.ModelName.IsNull() {
+		importModelState(&state, model)
+	} else {
+		refreshModelState(&state, model)
 	}
-	if apiBase := d.Get("model_api_base").(string); apiBase != "" {
-		litellmParams["api_base"] = apiBase
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state modelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if apiVersion := d.Get("api_version").(string); apiVersion != "" {
-		litellmParams["api_version"] = apiVersion
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if inputCostPerPixel := d.Get("input_cost_per_pixel").(float64); inputCostPerPixel > 0 {
-		litellmParams["input_cost_per_pixel"] = inputCostPerPixel
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	modelID, err := r.writeModel(ctx, &plan, &state, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating model", err.Error())
+		return
 	}
-	if outputCostPerPixel := d.Get("output_cost_per_pixel").(float64); outputCostPerPixel > 0 {
-		litellmParams["output_cost_per_pixel"] = outputCostPerPixel
+
+	plan.ID = types.StringValue(modelID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *modelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state modelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if inputCostPerSecond := d.Get("input_cost_per_second").(float64); inputCostPerSecond > 0 {
-		litellmParams["input_cost_per_second"] = inputCostPerSecond
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if outputCostPerSecond := d.Get("output_cost_per_second").(float64); outputCostPerSecond > 0 {
-		litellmParams["output_cost_per_second"] = outputCostPerSecond
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := r.client.withContext(ctx)
+	deleteReq := struct {
+		ID string `json:"id"`
+	}{
+		ID: state.ID.ValueString(),
 	}
-	if awsAccessKeyID := d.Get("aws_access_key_id").(string); awsAccessKeyID != "" {
-		litellmParams["aws_access_key_id"] = awsAccessKeyID
+
+	httpResp, err := MakeRequest(client, "POST", endpointModelDelete, deleteReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting model", fmt.Sprintf("failed to delete model: %s", err))
+		return
 	}
-	if awsSecretAccessKey := d.Get("aws_secret_access_key").(string); awsSecretAccessKey != "" {
-		litellmParams["aws_secret_access_key"] = awsSecretAccessKey
+	defer httpResp.Body.Close()
+
+	if _, err := handleAPIResponse(httpResp, deleteReq, client); err != nil && err.Error() != "model_not_found" {
+		resp.Diagnostics.AddError("Error deleting model", fmt.Sprintf("failed to delete model: %s", err))
 	}
-	if awsRegionName := d.Get("aws_region_name").(string); awsRegionName != "" {
-		litellmParams["aws_region_name"] = awsRegionName
+}
+
+// writeModel creates or updates a model and waits until it can be read back.
+// prior is nil on create. An update of a model deleted outside of Terraform recreates it.
+func (r *modelResource) writeModel(ctx context.Context, plan, prior *modelResourceModel, modelID string) (string, error) {
+	client := r.client.withContext(ctx)
+	isUpdate := prior != nil
+	action := map[bool]string{true: "update", false: "create"}[isUpdate]
+
+	modelReq := buildModelRequest(plan, prior, modelID)
+
+	endpoint := endpointModelNew
+	if isUpdate {
+		endpoint = endpointModelUpdate
 	}
-	if awsSessionName := d.Get("aws_session_name").(string); awsSessionName != "" {
-		litellmParams["aws_session_name"] = awsSessionName
+
+	httpResp, err := MakeRequest(client, "POST", endpoint, modelReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to %s model: %w", action, err)
 	}
-	if awsRoleName := d.Get("aws_role_name").(string); awsRoleName != "" {
-		litellmParams["aws_role_name"] = awsRoleName
+	defer httpResp.Body.Close()
+
+	if _, err := handleAPIResponse(httpResp, modelReq, client); err != nil {
+		if isUpdate && err.Error() == "model_not_found" {
+			log.Printf("[WARN] Model %s not found, creating it", modelID)
+			return r.writeModel(ctx, plan, nil, modelID)
+		}
+		return "", fmt.Errorf("failed to %s model: %w", action, err)
 	}
-	if vertexProject := d.Get("vertex_project").(string); vertexProject != "" {
-		litellmParams["vertex_project"] = vertexProject
+
+	log.Printf("[INFO] Model %sd with ID %s. Starting retry mechanism to read the model...", action, modelID)
+	if err := waitForModel(client, modelID, modelReadRetries); err != nil {
+		return "", err
 	}
-	if vertexLocation := d.Get("vertex_location").(string); vertexLocation != "" {
-		litellmParams["vertex_location"] = vertexLocation
+	return modelID, nil
+}
+
+// buildModelRequest converts the planned attributes into a model request. Only
+// attributes set in the configuration are sent, so explicit zero and false values
+// reach the API, and attributes removed since the prior state are sent as null.
+func buildModelRequest(plan, prior *modelResourceModel, modelID string) *ModelRequest {
+	if prior == nil {
+		prior = &modelResourceModel{}
+	}
+
+	customLLMProvider := plan.CustomLLMProvider.ValueString()
+	baseModel := plan.BaseModel.ValueString()
+
+	params := map[string]interface{}{
+		"custom_llm_provider": customLLMProvider,
+		"model":               fmt.Sprintf("%s/%s", customLLMProvider, baseModel),
+	}
+
+	putInt64Value(params, "tpm", plan.TPM, prior.TPM)
+	putInt64Value(params, "rpm", plan.RPM, prior.RPM)
+	putBoolValue(params, "merge_reasoning_content_in_choices", plan.MergeReasoningContentInChoices, prior.MergeReasoningContentInChoices)
+
+	// Costs are configured per million tokens but sent per token
+	putFloat64Value(params, "input_cost_per_token", plan.InputCostPerMillionTokens, prior.InputCostPerMillionTokens, 1000000.0)
+	putFloat64Value(params, "output_cost_per_token", plan.OutputCostPerMillionTokens, prior.OutputCostPerMillionTokens, 1000000.0)
+	putFloat64Value(params, "input_cost_per_pixel", plan.InputCostPerPixel, prior.InputCostPerPixel, 1)
+	putFloat64Value(params, "output_cost_per_pixel", plan.OutputCostPerPixel, prior.OutputCostPerPixel, 1)
+	putFloat64Value(params, "input_cost_per_second", plan.InputCostPerSecond, prior.InputCostPerSecond, 1)
+	putFloat64Value(params, "output_cost_per_second", plan.OutputCostPerSecond, prior.OutputCostPerSecond, 1)
+
+	for key, values := range map[string][2]types.String{
+		"api_key":               {plan.ModelAPIKey, prior.ModelAPIKey},
+		"api_base":              {plan.ModelAPIBase, prior.ModelAPIBase},
+		"api_version":           {plan.APIVersion, prior.APIVersion},
+		"aws_access_key_id":     {plan.AWSAccessKeyID, prior.AWSAccessKeyID},
+		"aws_secret_access_key": {plan.AWSSecretAccessKey, prior.AWSSecretAccessKey},
+		"aws_region_name":       {plan.AWSRegionName, prior.AWSRegionName},
+		"aws_session_name":      {plan.AWSSessionName, prior.AWSSessionName},
+		"aws_role_name":         {plan.AWSRoleName, prior.AWSRoleName},
+		"vertex_project":        {plan.VertexProject, prior.VertexProject},
+		"vertex_location":       {plan.VertexLocation, prior.VertexLocation},
+		"vertex_credentials":    {plan.VertexCredentials, prior.VertexCredentials},
+		"reasoning_effort":      {plan.ReasoningEffort, prior.ReasoningEffort},
+	} {
+		putStringValue(params, key, values[0], values[1])
+	}
+
+	if plan.ThinkingEnabled.ValueBool() {
+		params["thinking"] = map[string]interface{}{
+			"type":          "enabled",
+			"budget_tokens": plan.ThinkingBudgetTokens.ValueInt64(),
+		}
+	} else if prior.ThinkingEnabled.ValueBool() {
+		params["thinking"] = nil
 	}
-	if vertexCredentials := d.Get("vertex_credentials").(string); vertexCredentials != "" {
-		litellmParams["vertex_credentials"] = vertexCredentials
+
+	additional := map[string]string{}
+	if !plan.AdditionalLiteLLMParams.IsNull() && !plan.AdditionalLiteLLMParams.IsUnknown() {
+		for key, value := range plan.AdditionalLiteLLMParams.Elements() {
+			if s, ok := value.(types.String); ok {
+				additional[key] = s.ValueString()
+			}
+		}
 	}
-	if reasoningEffort := d.Get("reasoning_effort").(string); reasoningEffort != "" {
-		litellmParams["reasoning_effort"] = reasoningEffort
+	for key := range prior.AdditionalLiteLLMParams.Elements() {
+		if _, ok := additional[key]; !ok && key != "additional_drop_params" {
+			params[key] = nil
+		}
 	}
-	if thinking != nil {
-		litellmParams["thinking"] = thinking
+	expandAdditionalLiteLLMParams(params, additional)
+
+	info := map[string]interface{}{
+		"id":         modelID,
+		"db_model":   true,
+		"base_model": baseModel,
 	}
+	putStringValue(info, "tier", plan.Tier, prior.Tier)
+	putStringValue(info, "mode", plan.Mode, prior.Mode)
+	putStringValue(info, "team_id", plan.TeamID, prior.TeamID)
 
-	// Add additional parameters if provided
-	if additionalParams, ok := d.GetOk("additional_litellm_params"); ok {
-		var dropParams []string
+	return &ModelRequest{
+		ModelName:     plan.ModelName.ValueString(),
+		LiteLLMParams: params,
+		ModelInfo:     info,
+		Additional:    make(map[string]interface{}),
+	}
+}
 
-		for key, value := range additionalParams.(map[string]interface{}) {
-			// Convert string values to appropriate types where possible
-			if strValue, ok := value.(string); ok {
-				// Check if it's JSON (starts with [ or {)
-				trimmedValue := strings.TrimSpace(strValue)
-				if strings.HasPrefix(trimmedValue, "[") || strings.HasPrefix(trimmedValue, "{") {
-					var parsedValue interface{}
-					if err := json.Unmarshal([]byte(strValue), &parsedValue); err == nil {
-						// Successfully parsed JSON
-						if key == "additional_drop_params" {
-							// Handle drop params specially
-							if dropList, ok := parsedValue.([]interface{}); ok {
-								for _, item := range dropList {
-									if paramStr, ok := item.(string); ok {
-										dropParams = append(dropParams, paramStr)
-									}
-								}
-							}
-							continue // Don't add to litellmParams
-						} else {
-							litellmParams[key] = parsedValue
-						}
-					} else {
-						// Not valid JSON, apply existing conversion logic
-						if strValue == "true" {
-							litellmParams[key] = true
-						} else if strValue == "false" {
-							litellmParams[key] = false
-						} else {
-							// Try to convert numeric strings
-							if intValue, err := strconv.Atoi(strValue); err == nil {
-								litellmParams[key] = intValue
-							} else if floatValue, err := strconv.ParseFloat(strValue, 64); err == nil {
-								litellmParams[key] = floatValue
-							} else {
-								// Keep as string
-								litellmParams[key] = strValue
+// expandAdditionalLiteLLMParams adds additional_litellm_params to litellm_params.
+// Values are converted to JSON, booleans or numbers where possible, and the
+// parameters listed in additional_drop_params are removed.
+func expandAdditionalLiteLLMParams(params map[string]interface{}, additional map[string]string) {
+	var dropParams []string
+
+	for key, strValue := range additional {
+		// Check if it's JSON (starts with [ or {)
+		trimmedValue := strings.TrimSpace(strValue)
+		if strings.HasPrefix(trimmedValue, "[") || strings.HasPrefix(trimmedValue, "{") {
+			var parsedValue interface{}
+			if err := json.Unmarshal([]byte(strValue), &parsedValue); err == nil {
+				if key == "additional_drop_params" {
+					// Handle drop params specially
+					if dropList, ok := parsedValue.([]interface{}); ok {
+						for _, item := range dropList {
+							if paramStr, ok := item.(string); ok {
+								dropParams = append(dropParams, paramStr)
 							}
 						}
 					}
-				} else {
-					// Apply existing conversion logic for non-JSON strings
-					if strValue == "true" {
-						litellmParams[key] = true
-					} else if strValue == "false" {
-						litellmParams[key] = false
-					} else {
-						// Try to convert numeric strings
-						if intValue, err := strconv.Atoi(strValue); err == nil {
-							litellmParams[key] = intValue
-						} else if floatValue, err := strconv.ParseFloat(strValue, 64); err == nil {
-							litellmParams[key] = floatValue
-						} else {
-							// Keep as string
-							litellmParams[key] = strValue
-						}
-					}
+					continue // Don't add to litellm_params
 				}
-			} else {
-				litellmParams[key] = value
+				params[key] = parsedValue
+				continue
 			}
 		}
 
-		// Apply drop params at the end
-		for _, paramToDrop := range dropParams {
-			delete(litellmParams, paramToDrop)
+		// Not JSON, convert booleans and numeric strings
+		if strValue == "true" {
+			params[key] = true
+		} else if strValue == "false" {
+			params[key] = false
+		} else if intValue, err := strconv.Atoi(strValue); err == nil {
+			params[key] = intValue
+		} else if floatValue, err := strconv.ParseFloat(strValue, 64); err == nil {
+			params[key] = floatValue
+		} else {
+			params[key] = strValue
 		}
 	}
 
-	modelReq := ModelRequest{
-		ModelName:     d.Get("model_name").(string),
-		LiteLLMParams: litellmParams,
-		ModelInfo: ModelInfo{
-			ID:        modelID,
-			DBModel:   true,
-			BaseModel: baseModel,
-			Tier:      d.Get("tier").(string),
-			Mode:      d.Get("mode").(string),
-			TeamID:    d.Get("team_id").(string),
-		},
-		Additional: make(map[string]interface{}),
+	// Apply drop params at the end
+	for _, paramToDrop := range dropParams {
+		delete(params, paramToDrop)
 	}
+}
 
-	endpoint := endpointModelNew
-	if isUpdate {
-		endpoint = endpointModelUpdate
-	}
+// refreshModelState updates the configured attributes with the values returned
+// by the API. Secrets are never returned and keep their state value.
+func refreshModelState(state *modelResourceModel, model *DeployedModel) {
+	params := model.LiteLLMParams
 
-	resp, err := MakeRequest(client, "POST", endpoint, modelReq)
-	if err != nil {
-		return fmt.Errorf("failed to %s model: %w", map[bool]string{true: "update", false: "create"}[isUpdate], err)
-	}
-	defer resp.Body.Close()
+	state.ModelName = refreshModelString(state.ModelName, model.ModelName)
+	state.BaseModel = refreshModelString(state.BaseModel, model.ModelInfo.BaseModel)
+	state.Tier = refreshModelString(state.Tier, model.ModelInfo.Tier)
+	state.Mode = refreshModelString(state.Mode, model.ModelInfo.Mode)
+	state.TeamID = refreshModelString(state.TeamID, model.ModelInfo.TeamID)
 
-	_, err = handleAPIResponse(resp, modelReq, client)
-	if err != nil {
-		if isUpdate && err.Error() == "model_not_found" {
-			return createOrUpdateModel(d, m, false)
+	if s, ok := params["custom_llm_provider"].(string); ok {
+		state.CustomLLMProvider = refreshModelString(state.CustomLLMProvider, s)
+	}
+	for _, field := range []struct {
+		key   string
+		value *types.String
+	}{
+		{"api_base", &state.ModelAPIBase},
+		{"api_version", &state.APIVersion},
+		{"aws_region_name", &state.AWSRegionName},
+		{"reasoning_effort", &state.ReasoningEffort},
+	} {
+		if s, ok := params[field.key].(string); ok {
+			*field.value = refreshModelString(*field.value, s)
 		}
-		return fmt.Errorf("failed to %s model: %w", map[bool]string{true: "update", false: "create"}[isUpdate], err)
 	}
 
-	d.SetId(modelID)
+	// Explicit zero values returned by the API are kept
+	if v, ok := params["tpm"].(float64); ok && !state.TPM.IsNull() {
+		state.TPM = types.Int64Value(int64(v))
+	}
+	if v, ok := params["rpm"].(float64); ok && !state.RPM.IsNull() {
+		state.RPM = types.Int64Value(int64(v))
+	}
+	for _, field := range []struct {
+		key   string
+		value *types.Float64
+	}{
+		{"input_cost_per_pixel", &state.InputCostPerPixel},
+		{"output_cost_per_pixel", &state.OutputCostPerPixel},
+		{"input_cost_per_second", &state.InputCostPerSecond},
+		{"output_cost_per_second", &state.OutputCostPerSecond},
+	} {
+		if v, ok := params[field.key].(float64); ok && !field.value.IsNull() {
+			*field.value = types.Float64Value(v)
+		}
+	}
+	if v, ok := params["merge_reasoning_content_in_choices"].(bool); ok && !state.MergeReasoningContentInChoices.IsNull() {
+		state.MergeReasoningContentInChoices = types.BoolValue(v)
+	}
 
-	log.Printf("[INFO] Model created with ID %s. Starting retry mechanism to read the model...", modelID)
-	// Read back the resource with retries to ensure the state is consistent
-	return retryModelRead(d, m, 5)
+	if thinking, ok := params["thinking"].(map[string]interface{}); ok {
+		thinkingType, _ := thinking["type"].(string)
+		state.ThinkingEnabled = types.BoolValue(thinkingType == "enabled")
+		if budgetTokens, ok := thinking["budget_tokens"].(float64); ok {
+			state.ThinkingBudgetTokens = types.Int64Value(int64(budgetTokens))
+		}
+	}
 }
 
-func resourceLiteLLMModelCreate(d *schema.ResourceData, m interface{}) error {
-	return createOrUpdateModel(d, m, false)
-}
+// importModelState populates the state of an imported model from the API. Secrets
+// are not returned by the API and must be set in the configuration.
+func importModelState(state *modelResourceModel, model *DeployedModel) {
+	params := model.LiteLLMParams
 
-func resourceLiteLLMModelRead(d *schema.ResourceData, m interface{}) error {
-	client, ok := m.(*Client)
-	if !ok {
-		return fmt.Errorf("invalid type assertion for client")
-	}
+	state.ModelName = types.StringValue(model.ModelName)
+	state.BaseModel = types.StringValue(model.ModelInfo.BaseModel)
+	state.Tier = types.StringValue(GetStringValue(model.ModelInfo.Tier, "free"))
+	state.ThinkingEnabled = types.BoolValue(false)
+	state.ThinkingBudgetTokens = types.Int64Value(1024)
 
-	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?litellm_model_id=%s", endpointModelInfo, d.Id()), nil)
-	if err != nil {
-		return fmt.Errorf("failed to read model: %w", err)
+	provider, _ := params["custom_llm_provider"].(string)
+	if provider == "" {
+		// The model is sent as "custom_llm_provider/base_model"
+		modelPath, _ := params["model"].(string)
+		provider = strings.SplitN(modelPath, "/", 2)[0]
+	}
+	state.CustomLLMProvider = types.StringValue(provider)
+	if state.BaseModel.ValueString() == "" {
+		modelPath, _ := params["model"].(string)
+		state.BaseModel = types.StringValue(strings.TrimPrefix(modelPath, provider+"/"))
 	}
-	defer resp.Body.Close()
 
-	modelResp, err := handleAPIResponse(resp, nil, client)
-	if err != nil {
-		if err.Error() == "model_not_found" {
-			d.SetId("")
-			return nil
-		}
-		return fmt.Errorf("failed to read model: %w", err)
-	}
-
-	// Update the state with values from the response or fall back to the data passed in during creation
-	d.Set("model_name", GetStringValue(modelResp.ModelName, d.Get("model_name").(string)))
-	d.Set("custom_llm_provider", GetStringValue(modelResp.LiteLLMParams.CustomLLMProvider, d.Get("custom_llm_provider").(string)))
-	d.Set("tpm", GetIntValue(modelResp.LiteLLMParams.TPM, d.Get("tpm").(int)))
-	d.Set("rpm", GetIntValue(modelResp.LiteLLMParams.RPM, d.Get("rpm").(int)))
-	d.Set("model_api_base", GetStringValue(modelResp.LiteLLMParams.APIBase, d.Get("model_api_base").(string)))
-	d.Set("api_version", GetStringValue(modelResp.LiteLLMParams.APIVersion, d.Get("api_version").(string)))
-	d.Set("base_model", GetStringValue(modelResp.ModelInfo.BaseModel, d.Get("base_model").(string)))
-	d.Set("tier", GetStringValue(modelResp.ModelInfo.Tier, d.Get("tier").(string)))
-	d.Set("mode", GetStringValue(modelResp.ModelInfo.Mode, d.Get("mode").(string)))
-	d.Set("team_id", GetStringValue(modelResp.ModelInfo.TeamID, d.Get("team_id").(string)))
-
-	// Store sensitive information
-	d.Set("model_api_key", d.Get("model_api_key"))
-	d.Set("aws_access_key_id", d.Get("aws_access_key_id"))
-	d.Set("aws_secret_access_key", d.Get("aws_secret_access_key"))
-	d.Set("aws_region_name", GetStringValue(modelResp.LiteLLMParams.AWSRegionName, d.Get("aws_region_name").(string)))
-	d.Set("aws_session_name", d.Get("aws_session_name"))
-	d.Set("aws_role_name", d.Get("aws_role_name"))
-
-	// Store cost information
-	d.Set("input_cost_per_million_tokens", d.Get("input_cost_per_million_tokens"))
-	d.Set("output_cost_per_million_tokens", d.Get("output_cost_per_million_tokens"))
-
-	// Handle thinking configuration
-	if _, ok := d.GetOk("thinking_enabled"); ok {
-		// Keep the existing value from state
-		thinkingEnabled := d.Get("thinking_enabled").(bool)
-		d.Set("thinking_enabled", thinkingEnabled)
-
-		// Only set thinking_budget_tokens if thinking is enabled and we have a value in state
-		if thinkingEnabled {
-			if _, ok := d.GetOk("thinking_budget_tokens"); ok {
-				d.Set("thinking_budget_tokens", d.Get("thinking_budget_tokens").(int))
-			}
+	for _, field := range []struct {
+		value     string
+		attribute *types.String
+	}{
+		{model.ModelInfo.Mode, &state.Mode},
+		{model.ModelInfo.TeamID, &state.TeamID},
+	} {
+		if field.value != "" {
+			*field.attribute = types.StringValue(field.value)
 		}
-	} else {
-		// Fall back to API response if no state value exists
-		if modelResp.LiteLLMParams.Thinking != nil {
-			if thinkingType, ok := modelResp.LiteLLMParams.Thinking["type"].(string); ok && thinkingType == "enabled" {
-				d.Set("thinking_enabled", true)
-				if budgetTokens, ok := modelResp.LiteLLMParams.Thinking["budget_tokens"].(float64); ok {
-					d.Set("thinking_budget_tokens", int(budgetTokens))
-				}
-			} else {
-				d.Set("thinking_enabled", false)
-			}
-		} else {
-			d.Set("thinking_enabled", false)
+	}
+	for _, field := range []struct {
+		key       string
+		attribute *types.String
+	}{
+		{"api_base", &state.ModelAPIBase},
+		{"api_version", &state.APIVersion},
+		{"aws_region_name", &state.AWSRegionName},
+		{"reasoning_effort", &state.ReasoningEffort},
+	} {
+		if s, ok := params[field.key].(string); ok && s != "" {
+			*field.attribute = types.StringValue(s)
 		}
 	}
 
-	// Handle merge_reasoning_content_in_choices - preserve state value if not returned by API
-	if _, ok := d.GetOk("merge_reasoning_content_in_choices"); ok {
-		// Keep the existing value from state
-		d.Set("merge_reasoning_content_in_choices", d.Get("merge_reasoning_content_in_choices").(bool))
-	} else {
-		// Only set from API response if we don't have a value in state
-		d.Set("merge_reasoning_content_in_choices", modelResp.LiteLLMParams.MergeReasoningContentInChoices)
+	if v, ok := params["tpm"].(float64); ok {
+		state.TPM = types.Int64Value(int64(v))
 	}
-
-	// Preserve additional_litellm_params from state since API might not return all custom parameters
-	if _, ok := d.GetOk("additional_litellm_params"); ok {
-		d.Set("additional_litellm_params", d.Get("additional_litellm_params"))
+	if v, ok := params["rpm"].(float64); ok {
+		state.RPM = types.Int64Value(int64(v))
+	}
+	for _, field := range []struct {
+		key        string
+		multiplier float64
+		attribute  *types.Float64
+	}{
+		{"input_cost_per_token", 1000000.0, &state.InputCostPerMillionTokens},
+		{"output_cost_per_token", 1000000.0, &state.OutputCostPerMillionTokens},
+		{"input_cost_per_pixel", 1, &state.InputCostPerPixel},
+		{"output_cost_per_pixel", 1, &state.OutputCostPerPixel},
+		{"input_cost_per_second", 1, &state.InputCostPerSecond},
+		{"output_cost_per_second", 1, &state.OutputCostPerSecond},
+	} {
+		if v, ok := params[field.key].(float64); ok {
+			// Round away the float error of the per token conversion
+			*field.attribute = types.Float64Value(math.Round(v*field.multiplier*1e9) / 1e9)
+		}
+	}
+	if v, ok := params["merge_reasoning_content_in_choices"].(bool); ok {
+		state.MergeReasoningContentInChoices = types.BoolValue(v)
 	}
 
-	return nil
+	if thinking, ok := params["thinking"].(map[string]interface{}); ok {
+		thinkingType, _ := thinking["type"].(string)
+		state.ThinkingEnabled = types.BoolValue(thinkingType == "enabled")
+		if budgetTokens, ok := thinking["budget_tokens"].(float64); ok {
+			state.ThinkingBudgetTokens = types.Int64Value(int64(budgetTokens))
+		}
+	}
 }
 
-func resourceLiteLLMModelUpdate(d *schema.ResourceData, m interface{}) error {
-	return createOrUpdateModel(d, m, true)
+// refreshModelString returns the API value of a configured attribute, falling
+// back to the state when the API returns nothing.
+func refreshModelString(current types.String, apiValue string) types.String {
+	if current.IsNull() || apiValue == "" {
+		return current
+	}
+	return types.StringValue(apiValue)
 }
 
-func resourceLiteLLMModelDelete(d *schema.ResourceData, m interface{}) error {
-	client, ok := m.(*Client)
-	if !ok {
-		return fmt.Errorf("invalid type assertion for client")
+// getModel reads a model by ID. It returns nil when the model does not exist.
+func getModel(client *Client, modelID string) (*DeployedModel, error) {
+	if model, ok := client.cachedModel(modelID); ok {
+		return model, nil
 	}
 
-	deleteReq := struct {
-		ID string `json:"id"`
-	}{
-		ID: d.Id(),
+	httpResp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?litellm_model_id=%s", endpointModelInfo, modelID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read model: %w", err)
 	}
+	defer httpResp.Body.Close()
 
-	resp, err := MakeRequest(client, "POST", endpointModelDelete, deleteReq)
+	body, err := ioutil.ReadAll(httpResp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to delete model: %w", err)
+		return nil, fmt.Errorf("failed to read response body: %v", err)
 	}
-	defer resp.Body.Close()
 
-	_, err = handleAPIResponse(resp, deleteReq, client)
-	if err != nil {
-		if err.Error() == "model_not_found" {
-			d.SetId("")
+	if httpResp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if err := json.Unmarshal(body, &errResp); err == nil && isModelNotFoundError(errResp) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read model: Status: %s, Response: %s", httpResp.Status, client.redactSensitiveData(string(body)))
+	}
+
+	var listResp ModelListResponse
+	if err := json.Unmarshal(body, &listResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %v", err)
+	}
+
+	for i := range listResp.Data {
+		if listResp.Data[i].ModelInfo.ID == modelID || listResp.Data[i].ModelInfo.ID == "" {
+			return &listResp.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForModel reads a model with exponential backoff until the proxy returns it.
+func waitForModel(client *Client, modelID string, maxRetries int) error {
+	delay := 1 * time.Second
+	maxDelay := 10 * time.Second
+
+	for i := 0; i < maxRetries; i++ {
+		log.Printf("[INFO] Attempting to read model (attempt %d/%d)", i+1, maxRetries)
+
+		model, err := getModel(client, modelID)
+		if err != nil {
+			return err
+		}
+		if model != nil {
+			log.Printf("[INFO] Successfully read model after %d attempts", i+1)
 			return nil
 		}
-		return fmt.Errorf("failed to delete model: %w", err)
+
+		if i < maxRetries-1 {
+			log.Printf("[INFO] Model not found yet, retrying in %v...", delay)
+			if err := sleepWithContext(client.context(), delay); err != nil {
+				return fmt.Errorf("stopped waiting for model %s: %w", modelID, err)
+			}
+
+			// Exponential backoff with a maximum delay
+			delay *= 2
+			if delay > maxDelay {
+				delay = maxDelay
+			}
+		}
 	}
 
-	d.SetId("")
-	return nil
+	log.Printf("[WARN] Failed to read model %s after %d attempts", modelID, maxRetries)
+	return fmt.Errorf("model %s was not found after %d attempts", modelID, maxRetries)
 }
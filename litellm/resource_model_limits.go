@@ -0,0 +1,380 @@
+package litellm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// modelLimit holds the budget and rate limits of a single model.
+type modelLimit struct {
+	Model          string
+	MaxBudget      float64
+	BudgetDuration string
+	RPM            int
+	TPM            int
+
+	// Configured holds the attributes set in the configuration, which are sent
+	// even when zero. When nil, only non-zero values are sent.
+	Configured map[string]bool
+}
+
+// sent reports whether an attribute of the limit is sent to the API.
+func (l modelLimit) sent(attribute string, nonZero bool) bool {
+	if l.Configured != nil {
+		return l.Configured[attribute]
+	}
+	return nonZero
+}
+
+// modelLimitsSchema returns the model_limits block shared by teams and
+// organizations. Teams only support per-model rate limits, as the proxy has no
+// per-model budgets for teams.
+func modelLimitsSchema(withBudget bool) *schema.Schema {
+	elem := map[string]*schema.Schema{
+		"model": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Model name or access group the limits apply to",
+		},
+		"rpm": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Requests per minute allowed for the model",
+		},
+		"tpm": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Tokens per minute allowed for the model",
+		},
+	}
+
+	if withBudget {
+		elem["max_budget"] = &schema.Schema{
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Maximum spend on the model",
+		}
+		elem["budget_duration"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateKeyDuration,
+			Description:  "Period after which the model budget is reset, e.g. 30d",
+		}
+	}
+
+	description := "Per-model budgets and rate limits"
+	if !withBudget {
+		description = "Per-model rate limits. Per-model budgets are not supported for teams"
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: description,
+		Elem:        &schema.Resource{Schema: elem},
+	}
+}
+
+// expandModelLimits converts the model_limits block into model limits. config
+// is the block as written in the configuration, it tells explicit zero values
+// apart from omitted ones.
+func expandModelLimits(set *schema.Set, config cty.Value) []modelLimit {
+	configured := configuredModelLimits(config)
+
+	limits := make([]modelLimit, 0, set.Len())
+	for _, v := range set.List() {
+		block := v.(map[string]interface{})
+		limit := modelLimit{
+			Model: block["model"].(string),
+			RPM:   block["rpm"].(int),
+			TPM:   block["tpm"].(int),
+		}
+		if maxBudget, ok := block["max_budget"].(float64); ok {
+			limit.MaxBudget = maxBudget
+		}
+		if budgetDuration, ok := block["budget_duration"].(string); ok {
+			limit.BudgetDuration = budgetDuration
+		}
+		if configured != nil {
+			limit.Configured = configured[limit.Model]
+			if limit.Configured == nil {
+				limit.Configured = map[string]bool{}
+			}
+		}
+		limits = append(limits, limit)
+	}
+	return limits
+}
+
+// configuredModelLimits returns the attributes set in the configuration for
+// each model of a model_limits block, or nil when the configuration is not known.
+func configuredModelLimits(config cty.Value) map[string]map[string]bool {
+	if config.IsNull() || !config.IsWhollyKnown() || !config.CanIterateElements() {
+		return nil
+	}
+
+	configured := map[string]map[string]bool{}
+	for it := config.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+		if block.IsNull() || !block.Type().IsObjectType() || !block.Type().HasAttribute("model") {
+			continue
+		}
+		model := block.GetAttr("model")
+		if model.IsNull() || model.Type() != cty.String {
+			continue
+		}
+
+		attributes := map[string]bool{}
+		for name := range block.Type().AttributeTypes() {
+			attributes[name] = !block.GetAttr(name).IsNull()
+		}
+		configured[model.AsString()] = attributes
+	}
+	return configured
+}
+
+// flattenModelLimits converts model limits into the model_limits block.
+func flattenModelLimits(limits []modelLimit, withBudget bool) []interface{} {
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Model < limits[j].Model })
+
+	result := make([]interface{}, 0, len(limits))
+	for _, limit := range limits {
+		block := map[string]interface{}{
+			"model": limit.Model,
+			"rpm":   limit.RPM,
+			"tpm":   limit.TPM,
+		}
+		if withBudget {
+			block["max_budget"] = limit.MaxBudget
+			block["budget_duration"] = limit.BudgetDuration
+		}
+		result = append(result, block)
+	}
+	return result
+}
+
+// collectModelLimits merges the per-model rate limit and budget maps of a key
+// or team into model limits.
+func collectModelLimits(rpm, tpm, budgets map[string]interface{}) []modelLimit {
+	byModel := map[string]*modelLimit{}
+	get := func(model string) *modelLimit {
+		if _, ok := byModel[model]; !ok {
+			byModel[model] = &modelLimit{Model: model}
+		}
+		return byModel[model]
+	}
+
+	for model, v := range rpm {
+		get(model).RPM = interfaceToInt(v)
+	}
+	for model, v := range tpm {
+		get(model).TPM = interfaceToInt(v)
+	}
+	for model, v := range budgets {
+		limit := get(model)
+		budget, ok := v.(map[string]interface{})
+		if !ok {
+			// Budgets set through the flat model_max_budget map are plain numbers
+			limit.MaxBudget = interfaceToFloat(v)
+			continue
+		}
+		limit.MaxBudget = interfaceToFloat(budget[keyModelBudgetFields[0]])
+		if s, ok := budget[keyModelBudgetFields[1]].(string); ok {
+			limit.BudgetDuration = s
+		}
+	}
+
+	limits := make([]modelLimit, 0, len(byModel))
+	for _, limit := range byModel {
+		limits = append(limits, *limit)
+	}
+	return limits
+}
+
+// Keys store model budgets as {budget_limit, time_period}.
+var keyModelBudgetFields = [2]string{"budget_limit", "time_period"}
+
+// expandKeyModelLimits converts model limits into the model_max_budget,
+// model_rpm_limit and model_tpm_limit fields of a key.
+func expandKeyModelLimits(limits []modelLimit) (budgets, rpm, tpm map[string]interface{}) {
+	budgets = map[string]interface{}{}
+	rpm = map[string]interface{}{}
+	tpm = map[string]interface{}{}
+
+	for _, limit := range limits {
+		if limit.sent("max_budget", limit.MaxBudget != 0) {
+			budget := map[string]interface{}{keyModelBudgetFields[0]: limit.MaxBudget}
+			if limit.sent("budget_duration", limit.BudgetDuration != "") {
+				budget[keyModelBudgetFields[1]] = limit.BudgetDuration
+			}
+			budgets[limit.Model] = budget
+		}
+		if limit.sent("rpm", limit.RPM != 0) {
+			rpm[limit.Model] = limit.RPM
+		}
+		if limit.sent("tpm", limit.TPM != 0) {
+			tpm[limit.Model] = limit.TPM
+		}
+	}
+	return budgets, rpm, tpm
+}
+
+// expandOrganizationModelLimits converts model limits into the model_max_budget field of an organization.
+func expandOrganizationModelLimits(limits []modelLimit) map[string]interface{} {
+	budgets := map[string]interface{}{}
+	for _, limit := range limits {
+		budget := map[string]interface{}{}
+		if limit.sent("max_budget", limit.MaxBudget != 0) {
+			budget["max_budget"] = limit.MaxBudget
+		}
+		if limit.sent("budget_duration", limit.BudgetDuration != "") {
+			budget["budget_duration"] = limit.BudgetDuration
+		}
+		if limit.sent("rpm", limit.RPM != 0) {
+			budget["rpm_limit"] = limit.RPM
+		}
+		if limit.sent("tpm", limit.TPM != 0) {
+			budget["tpm_limit"] = limit.TPM
+		}
+		budgets[limit.Model] = budget
+	}
+	return budgets
+}
+
+// flattenOrganizationModelLimits converts the model_max_budget field of an organization into model limits.
+func flattenOrganizationModelLimits(budgets map[string]interface{}) []modelLimit {
+	limits := make([]modelLimit, 0, len(budgets))
+	for model, v := range budgets {
+		budget, ok := v.(map[string]interface{})
+		if !ok {
+			log.Printf("[WARN] Ignoring unexpected budget for model %s: %v", model, v)
+			continue
+		}
+		limit := modelLimit{
+			Model:     model,
+			MaxBudget: interfaceToFloat(budget["max_budget"]),
+			RPM:       interfaceToInt(budget["rpm_limit"]),
+			TPM:       interfaceToInt(budget["tpm_limit"]),
+		}
+		if s, ok := budget["budget_duration"].(string); ok {
+			limit.BudgetDuration = s
+		}
+		limits = append(limits, limit)
+	}
+	return limits
+}
+
+// Teams store per-model rate limits in their metadata, where the proxy's rate limiter reads them.
+var teamModelLimitMetadataKeys = []string{"model_rpm_limit", "model_tpm_limit"}
+
+// expandTeamModelLimits returns the team metadata with the per-model rate limits merged in.
+func expandTeamModelLimits(limits []modelLimit, metadata map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(metadata)+len(teamModelLimitMetadataKeys))
+	for k, v := range metadata {
+		result[k] = v
+	}
+	if len(limits) > 0 {
+		_, rpm, tpm := expandKeyModelLimits(limits)
+		result[teamModelLimitMetadataKeys[0]] = rpm
+		result[teamModelLimitMetadataKeys[1]] = tpm
+	}
+	return result
+}
+
+// flattenTeamModelLimits splits the per-model rate limits out of the team metadata.
+func flattenTeamModelLimits(metadata map[string]interface{}) ([]modelLimit, map[string]interface{}) {
+	rest := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		rest[k] = v
+	}
+
+	rpm, _ := rest[teamModelLimitMetadataKeys[0]].(map[string]interface{})
+	tpm, _ := rest[teamModelLimitMetadataKeys[1]].(map[string]interface{})
+	for _, k := range teamModelLimitMetadataKeys {
+		delete(rest, k)
+	}
+
+	return collectModelLimits(rpm, tpm, nil), rest
+}
+
+// listDeployedModelNames returns the model group names, team model names and access groups deployed on the proxy.
+func listDeployedModelNames(client *Client) (map[string]bool, error) {
+	resp, err := MakeRequest(client, "GET", endpointModelInfo, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "listing models"); err != nil {
+		return nil, err
+	}
+
+	var listResp ModelListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		return nil, fmt.Errorf("error decoding model list response: %w", err)
+	}
+
+	names := map[string]bool{}
+	for _, model := range listResp.Data {
+		names[model.ModelName] = true
+		if model.ModelInfo.TeamPublicModelName != "" {
+			names[model.ModelInfo.TeamPublicModelName] = true
+		}
+		for _, group := range model.ModelInfo.AccessGroups {
+			names[group] = true
+		}
+	}
+	return names, nil
+}
+
+// modelNameDeployed reports whether a model name matches a deployed model, including
+// wildcard deployments such as openai/*. A referenced wildcard such as openai/* is
+// deployed when it matches at least one deployed name.
+func modelNameDeployed(model string, deployed map[string]bool) bool {
+	if deployed[model] {
+		return true
+	}
+	wildcard := strings.Contains(model, "*")
+	for name := range deployed {
+		if strings.Contains(name, "*") {
+			if matched, _ := path.Match(name, model); matched {
+				return true
+			}
+		}
+		if wildcard {
+			if matched, _ := path.Match(model, name); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// interfaceToInt converts a JSON number into an int.
+func interfaceToInt(v interface{}) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case int:
+		return n
+	}
+	return 0
+}
+
+// interfaceToFloat converts a JSON number into a float64.
+func interfaceToFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	}
+	return 0
+}
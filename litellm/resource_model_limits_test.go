@@ -0,0 +1,172 @@
+package litellm
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testModelLimitsSet(blocks ...map[string]interface{}) *schema.Set {
+	elements := make([]interface{}, 0, len(blocks))
+	for _, block := range blocks {
+		elements = append(elements, block)
+	}
+	return schema.NewSet(schema.HashResource(modelLimitsSchema(true).Elem.(*schema.Resource)), elements)
+}
+
+func TestExpandModelLimits(t *testing.T) {
+	set := testModelLimitsSet(
+		map[string]interface{}{"model": "gpt-4o", "max_budget": 0.0, "budget_duration": "", "rpm": 0, "tpm": 1000},
+		map[string]interface{}{"model": "claude", "max_budget": 10.0, "budget_duration": "30d", "rpm": 0, "tpm": 0},
+	)
+	config := cty.SetVal([]cty.Value{
+		cty.ObjectVal(map[string]cty.Value{
+			"model":           cty.StringVal("gpt-4o"),
+			"max_budget":      cty.NullVal(cty.Number),
+			"budget_duration": cty.NullVal(cty.String),
+			"rpm":             cty.NumberIntVal(0),
+			"tpm":             cty.NumberIntVal(1000),
+		}),
+		cty.ObjectVal(map[string]cty.Value{
+			"model":           cty.StringVal("claude"),
+			"max_budget":      cty.NumberFloatVal(10),
+			"budget_duration": cty.StringVal("30d"),
+			"rpm":             cty.NullVal(cty.Number),
+			"tpm":             cty.NullVal(cty.Number),
+		}),
+	})
+
+	got := expandOrganizationModelLimits(expandModelLimits(set, config))
+	want := map[string]interface{}{
+		"gpt-4o": map[string]interface{}{"rpm_limit": 0, "tpm_limit": 1000},
+		"claude": map[string]interface{}{"max_budget": 10.0, "budget_duration": "30d"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	// Without the configuration only non-zero limits are sent
+	got = expandOrganizationModelLimits(expandModelLimits(set, cty.NullVal(cty.DynamicPseudoType)))
+	want = map[string]interface{}{
+		"gpt-4o": map[string]interface{}{"tpm_limit": 1000},
+		"claude": map[string]interface{}{"max_budget": 10.0, "budget_duration": "30d"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestExpandKeyModelLimits(t *testing.T) {
+	limits := []modelLimit{
+		{Model: "gpt-4o", RPM: 0, TPM: 1000, Configured: map[string]bool{"model": true, "rpm": true, "tpm": true}},
+		{Model: "claude", MaxBudget: 10, BudgetDuration: "30d"},
+	}
+
+	budgets, rpm, tpm := expandKeyModelLimits(limits)
+	if want := map[string]interface{}{"claude": map[string]interface{}{"budget_limit": 10.0, "time_period": "30d"}}; !reflect.DeepEqual(budgets, want) {
+		t.Errorf("budgets = %#v, want %#v", budgets, want)
+	}
+	if want := map[string]interface{}{"gpt-4o": 0}; !reflect.DeepEqual(rpm, want) {
+		t.Errorf("rpm = %#v, want %#v", rpm, want)
+	}
+	if want := map[string]interface{}{"gpt-4o": 1000}; !reflect.DeepEqual(tpm, want) {
+		t.Errorf("tpm = %#v, want %#v", tpm, want)
+	}
+}
+
+func TestFlattenModelLimits(t *testing.T) {
+	limits := collectModelLimits(
+		map[string]interface{}{"gpt-4o": 100.0},
+		map[string]interface{}{"gpt-4o": 1000.0},
+		map[string]interface{}{
+			"claude": map[string]interface{}{"budget_limit": 10.0, "time_period": "30d"},
+			"gemini": 5.0,
+		},
+	)
+
+	got := flattenModelLimits(limits, true)
+	want := []interface{}{
+		map[string]interface{}{"model": "claude", "rpm": 0, "tpm": 0, "max_budget": 10.0, "budget_duration": "30d"},
+		map[string]interface{}{"model": "gemini", "rpm": 0, "tpm": 0, "max_budget": 5.0, "budget_duration": ""},
+		map[string]interface{}{"model": "gpt-4o", "rpm": 100, "tpm": 1000, "max_budget": 0.0, "budget_duration": ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	organizationLimits := flattenOrganizationModelLimits(map[string]interface{}{
+		"gpt-4o": map[string]interface{}{"max_budget": 10.0, "budget_duration": "30d", "rpm_limit": 100.0},
+	})
+	got = flattenModelLimits(organizationLimits, false)
+	want = []interface{}{
+		map[string]interface{}{"model": "gpt-4o", "rpm": 100, "tpm": 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestTeamModelLimitsMetadata(t *testing.T) {
+	limits := []modelLimit{{Model: "gpt-4o", RPM: 100}}
+	metadata := expandTeamModelLimits(limits, map[string]interface{}{"owner": "ml"})
+
+	flattened, rest := flattenTeamModelLimits(metadata)
+	if !reflect.DeepEqual(rest, map[string]interface{}{"owner": "ml"}) {
+		t.Errorf("metadata = %#v", rest)
+	}
+	if len(flattened) != 1 || flattened[0].Model != "gpt-4o" || flattened[0].RPM != 100 {
+		t.Errorf("limits = %#v", flattened)
+	}
+}
+
+func TestExpandKeyModelLimitsBlock(t *testing.T) {
+	limits := []keyModelLimitModel{{
+		Model:          types.StringValue("gpt-4o"),
+		MaxBudget:      types.Float64Value(0),
+		BudgetDuration: types.StringNull(),
+		RPM:            types.Int64Value(0),
+		TPM:            types.Int64Null(),
+	}}
+
+	budgets, rpm, tpm := expandKeyModelLimitsBlock(limits)
+	if want := map[string]interface{}{"gpt-4o": map[string]interface{}{"budget_limit": 0.0}}; !reflect.DeepEqual(budgets, want) {
+		t.Errorf("budgets = %#v, want %#v", budgets, want)
+	}
+	if want := map[string]interface{}{"gpt-4o": int64(0)}; !reflect.DeepEqual(rpm, want) {
+		t.Errorf("rpm = %#v, want %#v", rpm, want)
+	}
+	if len(tpm) != 0 {
+		t.Errorf("tpm = %#v, want it empty", tpm)
+	}
+}
+
+func TestFlattenKeyModelLimitsBlock(t *testing.T) {
+	ctx := context.Background()
+	prior := []keyModelLimitModel{{
+		Model:          types.StringValue("gpt-4o"),
+		MaxBudget:      types.Float64Null(),
+		BudgetDuration: types.StringNull(),
+		RPM:            types.Int64Value(0),
+		TPM:            types.Int64Null(),
+	}}
+
+	value, diags := flattenKeyModelLimitsBlock(ctx, []modelLimit{{Model: "gpt-4o"}}, prior)
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	got := modelLimitsBlockElements(ctx, value)
+	if len(got) != 1 {
+		t.Fatalf("got %d limits, want 1", len(got))
+	}
+	if !got[0].RPM.Equal(types.Int64Value(0)) {
+		t.Errorf("rpm = %s, want the configured 0", got[0].RPM)
+	}
+	if !got[0].TPM.IsNull() || !got[0].MaxBudget.IsNull() || !got[0].BudgetDuration.IsNull() {
+		t.Errorf("unset limits are not null: %#v", got[0])
+	}
+}
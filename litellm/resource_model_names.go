@@ -0,0 +1,175 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maxModelNameSuggestions is the number of close matches listed for an unknown model name.
+const maxModelNameSuggestions = 3
+
+// specialModelNames are model names that the proxy resolves itself rather than to a deployment.
+var specialModelNames = map[string]bool{
+	"all-proxy-models":  true,
+	"all-team-models":   true,
+	"no-default-models": true,
+	"*":                 true,
+}
+
+// validateModelNames checks at plan time that every name in models and
+// model_limits is a deployed model, model group, access group or matches a
+// wildcard deployment. The check is opt-in through the validate_model_names
+// provider argument.
+func validateModelNames(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client, ok := m.(*Client)
+	if !ok || client == nil || !client.ValidateModelNames {
+		return nil
+	}
+	client = client.withContext(ctx)
+
+	// Unknown values are validated once they are known
+	if (d.Id() == "" || d.HasChange("models")) && d.NewValueKnown("models") {
+		var models []string
+		for _, v := range d.Get("models").([]interface{}) {
+			if model, ok := v.(string); ok {
+				models = append(models, model)
+			}
+		}
+		if err := checkModelNames(client, "models", models); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("model_limits") {
+		var models []string
+		for _, v := range d.Get("model_limits").(*schema.Set).List() {
+			block, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if model, ok := block["model"].(string); ok {
+				models = append(models, model)
+			}
+		}
+		return checkModelNames(client, "model_limits", models)
+	}
+
+	return nil
+}
+
+// checkModelNames returns an error when the models of an attribute reference
+// names that are not deployed on the proxy. Special names and empty values are
+// not checked.
+func checkModelNames(client *Client, attribute string, models []string) error {
+	var checked []string
+	for _, model := range models {
+		if model != "" && !specialModelNames[model] {
+			checked = append(checked, model)
+		}
+	}
+	if len(checked) == 0 {
+		return nil
+	}
+
+	deployed, err := listDeployedModelNames(client)
+	if err != nil {
+		log.Printf("[WARN] Unable to list deployed models, skipping %s validation: %s", attribute, err)
+		return nil
+	}
+
+	return unknownModelNamesError(attribute, checked, deployed)
+}
+
+// unknownModelNamesError returns an error listing the models that are not deployed,
+// together with the closest deployed names, or nil when all of them are.
+func unknownModelNamesError(attribute string, models []string, deployed map[string]bool) error {
+	var unknown []string
+	for _, model := range models {
+		if modelNameDeployed(model, deployed) {
+			continue
+		}
+		if suggestions := closeModelNames(model, deployed); len(suggestions) > 0 {
+			model = fmt.Sprintf("%s (did you mean %s?)", model, strings.Join(suggestions, ", "))
+		}
+		unknown = append(unknown, model)
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s references models that are not deployed on the proxy: %s", attribute, strings.Join(unknown, "; "))
+}
+
+// closeModelNames returns the deployed names closest to model, comparing case
+// insensitively by edit distance or by one name containing the other.
+func closeModelNames(model string, deployed map[string]bool) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+
+	lower := strings.ToLower(model)
+	var candidates []candidate
+	for name := range deployed {
+		lowerName := strings.ToLower(name)
+		distance := levenshteinDistance(lower, lowerName)
+
+		limit := len(lower) / 3
+		if limit < 2 {
+			limit = 2
+		}
+		if distance <= limit || strings.Contains(lowerName, lower) || strings.Contains(lower, lowerName) {
+			candidates = append(candidates, candidate{name: name, distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	var names []string
+	for i := 0; i < len(candidates) && i < maxModelNameSuggestions; i++ {
+		names = append(names, candidates[i].name)
+	}
+	return names
+}
+
+// levenshteinDistance returns the number of single character edits between two strings.
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
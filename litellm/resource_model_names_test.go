@@ -0,0 +1,59 @@
+package litellm
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testDeployedModelNames = map[string]bool{
+	"gpt-4o":            true,
+	"gpt-4o-mini":       true,
+	"claude-3-5-sonnet": true,
+	"anthropic/*":       true,
+	"internal-models":   true,
+}
+
+func TestModelNameDeployed(t *testing.T) {
+	for model, want := range map[string]bool{
+		"gpt-4o":                   true,
+		"internal-models":          true,
+		"anthropic/claude-3-haiku": true,
+		"anthropic/*":              true,
+		// A referenced wildcard is deployed when it matches a deployed name
+		"gpt-4o*":  true,
+		"claude-*": true,
+		"openai/*": false,
+		"gpt-5":    false,
+		"GPT-4o":   false,
+	} {
+		if got := modelNameDeployed(model, testDeployedModelNames); got != want {
+			t.Errorf("%q: got %t, want %t", model, got, want)
+		}
+	}
+}
+
+func TestCloseModelNames(t *testing.T) {
+	for model, want := range map[string][]string{
+		"gpt4o":      {"gpt-4o"},
+		"GPT-4o":     {"gpt-4o", "gpt-4o-mini"},
+		"gpt-4o-min": {"gpt-4o-mini", "gpt-4o"},
+		"internal":   {"internal-models"},
+		"mistral":    nil,
+	} {
+		if got := closeModelNames(model, testDeployedModelNames); !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: got %v, want %v", model, got, want)
+		}
+	}
+}
+
+func TestUnknownModelNamesError(t *testing.T) {
+	if err := unknownModelNamesError("models", []string{"gpt-4o", "anthropic/claude-3-haiku", "claude-*"}, testDeployedModelNames); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	err := unknownModelNamesError("models", []string{"gpt-4o", "gpt4o", "mistral"}, testDeployedModelNames)
+	want := "models references models that are not deployed on the proxy: gpt4o (did you mean gpt-4o?); mistral"
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,229 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testModelStateV0 is the state the SDK implementation of litellm_model wrote,
+// with unset attributes stored as "", 0, false and {}.
+const testModelStateV0 = `{
+  "id": "7b0c2a4e-8f7a-4a53-9c1e-0f2b0d6f3a11",
+  "model_name": "gpt-4o",
+  "custom_llm_provider": "openai",
+  "tpm": 0,
+  "rpm": 100,
+  "reasoning_effort": "",
+  "thinking_enabled": false,
+  "thinking_budget_tokens": 1024,
+  "merge_reasoning_content_in_choices": false,
+  "model_api_key": "sk-provider",
+  "model_api_base": "",
+  "api_version": "",
+  "base_model": "gpt-4o",
+  "tier": "free",
+  "team_id": "",
+  "mode": "chat",
+  "input_cost_per_million_tokens": 2.5,
+  "output_cost_per_million_tokens": 0,
+  "input_cost_per_pixel": 0,
+  "output_cost_per_pixel": 0,
+  "input_cost_per_second": 0,
+  "output_cost_per_second": 0,
+  "aws_access_key_id": "",
+  "aws_secret_access_key": "",
+  "aws_region_name": "",
+  "aws_session_name": "",
+  "aws_role_name": "",
+  "vertex_project": "",
+  "vertex_location": "",
+  "vertex_credentials": "",
+  "additional_litellm_params": {}%s
+}`
+
+func TestModelResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	providerServer, err := testAccProtoV6ProviderFactories["litellm"]()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	stateType := schemaResp.ResourceSchemas["litellm_model"].ValueType()
+
+	cases := []struct {
+		name     string
+		timeouts string
+		create   tftypes.Value
+	}{
+		{
+			name:   "without timeouts",
+			create: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			name:     "null timeouts",
+			timeouts: `, "timeouts": null`,
+			create:   tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			name:     "with timeouts",
+			timeouts: `, "timeouts": {"create": "15m", "read": null, "update": null, "delete": null}`,
+			create:   tftypes.NewValue(tftypes.String, "15m"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := providerServer.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: "litellm_model",
+				Version:  0,
+				RawState: &tfprotov6.RawState{JSON: []byte(fmt.Sprintf(testModelStateV0, tc.timeouts))},
+			})
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("%s: %s", d.Summary, d.Detail)
+			}
+
+			upgraded, err := resp.UpgradedState.Unmarshal(stateType)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			var attributes map[string]tftypes.Value
+			if err := upgraded.As(&attributes); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			// Unset attributes become null, configured values and defaults are kept
+			for _, name := range []string{
+				"tpm", "reasoning_effort", "merge_reasoning_content_in_choices", "model_api_base",
+				"team_id", "output_cost_per_million_tokens", "aws_region_name", "vertex_credentials",
+				"additional_litellm_params",
+			} {
+				if !attributes[name].IsNull() {
+					t.Errorf("%s: expected null, got %s", name, attributes[name])
+				}
+			}
+			for name, want := range map[string]tftypes.Value{
+				"id":                            tftypes.NewValue(tftypes.String, "7b0c2a4e-8f7a-4a53-9c1e-0f2b0d6f3a11"),
+				"rpm":                           tftypes.NewValue(tftypes.Number, 100),
+				"model_api_key":                 tftypes.NewValue(tftypes.String, "sk-provider"),
+				"mode":                          tftypes.NewValue(tftypes.String, "chat"),
+				"tier":                          tftypes.NewValue(tftypes.String, "free"),
+				"thinking_enabled":              tftypes.NewValue(tftypes.Bool, false),
+				"thinking_budget_tokens":        tftypes.NewValue(tftypes.Number, 1024),
+				"input_cost_per_million_tokens": tftypes.NewValue(tftypes.Number, 2.5),
+			} {
+				if !attributes[name].Equal(want) {
+					t.Errorf("%s: expected %s, got %s", name, want, attributes[name])
+				}
+			}
+
+			var timeouts map[string]tftypes.Value
+			if attributes["timeouts"].IsNull() {
+				if !tc.create.IsNull() {
+					t.Fatalf("timeouts: expected create %s, got null", tc.create)
+				}
+				return
+			}
+			if err := attributes["timeouts"].As(&timeouts); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			if !timeouts["create"].Equal(tc.create) {
+				t.Errorf("timeouts.create: expected %s, got %s", tc.create, timeouts["create"])
+			}
+		})
+	}
+}
+
+func TestBuildModelRequest(t *testing.T) {
+	plan := &modelResourceModel{
+		ModelName:                      types.StringValue("gpt-4o"),
+		CustomLLMProvider:              types.StringValue("openai"),
+		BaseModel:                      types.StringValue("gpt-4o"),
+		Tier:                           types.StringValue("free"),
+		TPM:                            types.Int64Value(0),
+		RPM:                            types.Int64Null(),
+		MergeReasoningContentInChoices: types.BoolValue(false),
+		InputCostPerMillionTokens:      types.Float64Value(2.5),
+		OutputCostPerMillionTokens:     types.Float64Null(),
+		ModelAPIKey:                    types.StringUnknown(),
+		ModelAPIBase:                   types.StringNull(),
+		ThinkingEnabled:                types.BoolValue(false),
+		ThinkingBudgetTokens:           types.Int64Value(1024),
+		AdditionalLiteLLMParams:        types.MapNull(types.StringType),
+	}
+
+	t.Run("create", func(t *testing.T) {
+		params := buildModelRequest(plan, nil, "id").LiteLLMParams
+
+		for key, want := range map[string]interface{}{
+			"model":                              "openai/gpt-4o",
+			"tpm":                                int64(0),
+			"merge_reasoning_content_in_choices": false,
+			"input_cost_per_token":               2.5 / 1000000.0,
+		} {
+			if params[key] != want {
+				t.Errorf("%s: expected %v, got %v", key, want, params[key])
+			}
+		}
+		// Null and unknown attributes are not sent
+		for _, key := range []string{"rpm", "output_cost_per_token", "api_key", "api_base", "thinking"} {
+			if v, ok := params[key]; ok {
+				t.Errorf("%s: expected no value, got %v", key, v)
+			}
+		}
+
+		info := buildModelRequest(plan, nil, "id").ModelInfo
+		if info["tier"] != "free" {
+			t.Errorf("tier: expected free, got %v", info["tier"])
+		}
+		for _, key := range []string{"mode", "team_id"} {
+			if v, ok := info[key]; ok {
+				t.Errorf("model_info.%s: expected no value, got %v", key, v)
+			}
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		prior := &modelResourceModel{
+			Mode:                       types.StringValue("chat"),
+			TeamID:                     types.StringValue("team-1"),
+			RPM:                        types.Int64Value(100),
+			OutputCostPerMillionTokens: types.Float64Value(10),
+			ModelAPIBase:               types.StringValue("https://example.com"),
+			ThinkingEnabled:            types.BoolValue(true),
+			AdditionalLiteLLMParams: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"drop_params": types.StringValue("true"),
+			}),
+		}
+		params := buildModelRequest(plan, prior, "id").LiteLLMParams
+
+		// Attributes removed from the configuration are cleared
+		for _, key := range []string{"rpm", "output_cost_per_token", "api_base", "thinking", "drop_params"} {
+			if v, ok := params[key]; !ok || v != nil {
+				t.Errorf("%s: expected null, got %v (set: %t)", key, v, ok)
+			}
+		}
+		if v, ok := params["api_key"]; ok {
+			t.Errorf("api_key: expected no value, got %v", v)
+		}
+
+		info := buildModelRequest(plan, prior, "id").ModelInfo
+		for _, key := range []string{"mode", "team_id"} {
+			if v, ok := info[key]; !ok || v != nil {
+				t.Errorf("model_info.%s: expected null, got %v (set: %t)", key, v, ok)
+			}
+		}
+	})
+}
@@ -0,0 +1,260 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// objectPermissionSchema returns the object_permission block shared by teams and keys.
+func objectPermissionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "MCP servers, tools and vector stores that may be used",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mcp_servers": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "IDs of the MCP servers that may be used",
+				},
+				"mcp_access_groups": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "MCP access groups whose servers may be used",
+				},
+				"mcp_tool_permissions": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Restrict the tools that may be called on an MCP server",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"server_id": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "ID of the MCP server",
+							},
+							"tools": {
+								Type:        schema.TypeSet,
+								Required:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Names of the tools that may be called",
+							},
+						},
+					},
+				},
+				"vector_stores": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "IDs of the vector stores that may be used",
+				},
+			},
+		},
+	}
+}
+
+// expandObjectPermission converts the object_permission block into the request
+// payload. A removed block is sent with empty lists so that access is revoked.
+func expandObjectPermission(d *schema.ResourceData) (*ObjectPermission, bool) {
+	if !d.HasChange("object_permission") {
+		if _, ok := d.GetOk("object_permission"); !ok {
+			return nil, false
+		}
+	}
+
+	permission := &ObjectPermission{
+		MCPServers:         []string{},
+		MCPAccessGroups:    []string{},
+		MCPToolPermissions: map[string][]string{},
+		VectorStores:       []string{},
+	}
+
+	blocks := d.Get("object_permission").([]interface{})
+	if len(blocks) > 0 && blocks[0] != nil {
+		block := blocks[0].(map[string]interface{})
+		permission.MCPServers = expandStringList(block["mcp_servers"].(*schema.Set).List())
+		permission.MCPAccessGroups = expandStringList(block["mcp_access_groups"].(*schema.Set).List())
+		permission.VectorStores = expandStringList(block["vector_stores"].(*schema.Set).List())
+		for _, v := range block["mcp_tool_permissions"].(*schema.Set).List() {
+			toolPermission := v.(map[string]interface{})
+			serverID := toolPermission["server_id"].(string)
+			permission.MCPToolPermissions[serverID] = expandStringList(toolPermission["tools"].(*schema.Set).List())
+		}
+	}
+
+	return permission, true
+}
+
+// flattenObjectPermission converts an object permission returned by the API into the object_permission block.
+func flattenObjectPermission(permission *ObjectPermission) []interface{} {
+	if permission == nil || (len(permission.MCPServers) == 0 && len(permission.MCPAccessGroups) == 0 &&
+		len(permission.MCPToolPermissions) == 0 && len(permission.VectorStores) == 0) {
+		return nil
+	}
+
+	toolPermissions := make([]interface{}, 0, len(permission.MCPToolPermissions))
+	for serverID, tools := range permission.MCPToolPermissions {
+		toolPermissions = append(toolPermissions, map[string]interface{}{
+			"server_id": serverID,
+			"tools":     tools,
+		})
+	}
+
+	block := map[string]interface{}{
+		"mcp_servers":          permission.MCPServers,
+		"mcp_access_groups":    permission.MCPAccessGroups,
+		"mcp_tool_permissions": toolPermissions,
+		"vector_stores":        permission.VectorStores,
+	}
+	return []interface{}{block}
+}
+
+// parseObjectPermission converts an object permission decoded as a generic JSON value.
+func parseObjectPermission(v interface{}) *ObjectPermission {
+	if v == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var permission ObjectPermission
+	if err := json.Unmarshal(data, &permission); err != nil {
+		log.Printf("[WARN] Error parsing object permission: %s", err)
+		return nil
+	}
+	return &permission
+}
+
+// validateObjectPermission checks at plan time that the MCP servers and vector
+// stores referenced by object_permission exist.
+func validateObjectPermission(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// IDs of resources created in the same apply are validated once they are known
+	if !d.HasChange("object_permission") || !d.NewValueKnown("object_permission") {
+		return nil
+	}
+
+	blocks := d.Get("object_permission").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	client, ok := m.(*Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	serverIDs := expandStringList(block["mcp_servers"].(*schema.Set).List())
+	for _, v := range block["mcp_tool_permissions"].(*schema.Set).List() {
+		serverIDs = append(serverIDs, v.(map[string]interface{})["server_id"].(string))
+	}
+
+	return checkObjectPermission(client.withContext(ctx), serverIDs,
+		expandStringList(block["mcp_access_groups"].(*schema.Set).List()),
+		expandStringList(block["vector_stores"].(*schema.Set).List()))
+}
+
+// checkObjectPermission returns an error listing the MCP servers and vector
+// stores of an object permission that do not exist. Unknown access groups are
+// only logged.
+func checkObjectPermission(client *Client, serverIDs, accessGroups, vectorStoreIDs []string) error {
+	var missing []string
+	checked := map[string]bool{}
+	for _, serverID := range serverIDs {
+		if serverID == "" || checked[serverID] {
+			continue
+		}
+		checked[serverID] = true
+
+		exists, err := mcpServerExists(client, serverID)
+		if err != nil {
+			log.Printf("[WARN] Unable to check MCP server %s, skipping validation: %s", serverID, err)
+			continue
+		}
+		if !exists {
+			missing = append(missing, fmt.Sprintf("MCP server %q", serverID))
+		}
+	}
+
+	for _, vectorStoreID := range vectorStoreIDs {
+		if vectorStoreID == "" {
+			continue
+		}
+		exists, err := vectorStoreExists(client, vectorStoreID)
+		if err != nil {
+			log.Printf("[WARN] Unable to check vector store %s, skipping validation: %s", vectorStoreID, err)
+			continue
+		}
+		if !exists {
+			missing = append(missing, fmt.Sprintf("vector store %q", vectorStoreID))
+		}
+	}
+
+	if len(accessGroups) > 0 {
+		groups, err := listMCPAccessGroups(client)
+		if err != nil {
+			log.Printf("[WARN] Unable to list MCP access groups, skipping validation: %s", err)
+		} else {
+			// A group only exists once an MCP server lists it, so a group
+			// declared ahead of its servers is not an error
+			for _, group := range accessGroups {
+				if group != "" && !groups[group] {
+					log.Printf("[WARN] MCP access group %q is not used by any MCP server yet", group)
+				}
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("object_permission references objects that do not exist: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// mcpServerExists reports whether an MCP server with the given ID exists.
+func mcpServerExists(client *Client, serverID string) (bool, error) {
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s/%s", endpointMCPServerRead, serverID), nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	var mcpResp MCPServerResponse
+	if err := handleMCPAPIResponse(resp, &mcpResp, client); err != nil {
+		if err.Error() == "mcp_server_not_found" {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// vectorStoreExists reports whether a vector store with the given ID exists.
+func vectorStoreExists(client *Client, vectorStoreID string) (bool, error) {
+	resp, err := MakeRequest(client, "POST", "/vector_store/info", VectorStoreInfoRequest{VectorStoreID: vectorStoreID})
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if err := handleVectorStoreAPIResponse(resp, nil, client); err != nil {
+		if err.Error() == "vector_store_not_found" {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -1,12 +1,15 @@
 package litellm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,10 +22,15 @@ const (
 
 func resourceLiteLLMOrganization() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLiteLLMOrganizationCreate,
-		Read:   resourceLiteLLMOrganizationRead,
-		Update: resourceLiteLLMOrganizationUpdate,
-		Delete: resourceLiteLLMOrganizationDelete,
+		CreateContext: resourceLiteLLMOrganizationCreate,
+		ReadContext:   resourceLiteLLMOrganizationRead,
+		UpdateContext: resourceLiteLLMOrganizationUpdate,
+		DeleteContext: resourceLiteLLMOrganizationDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"organization_alias": {
@@ -59,12 +67,17 @@ func resourceLiteLLMOrganization() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"model_limits": modelLimitsSchema(true),
 		},
+
+		CustomizeDiff: customdiff.All(
+			validateModelNames,
+		),
 	}
 }
 
-func resourceLiteLLMOrganizationCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	orgID := uuid.New().String()
 	orgData := buildOrganizationData(d, orgID)
@@ -73,22 +86,22 @@ func resourceLiteLLMOrganizationCreate(d *schema.ResourceData, m interface{}) er
 
 	resp, err := MakeRequest(client, "POST", endpointOrganizationNew, orgData)
 	if err != nil {
-		return fmt.Errorf("error creating organization: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating organization: %w", err))
 	}
 	defer resp.Body.Close()
 
 	if err := handleResponse(resp, "creating organization"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(orgID)
 	log.Printf("[INFO] Organization created with ID: %s", orgID)
 
-	return resourceLiteLLMOrganizationRead(d, m)
+	return resourceLiteLLMOrganizationRead(ctx, d, m)
 }
 
-func resourceLiteLLMOrganizationRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	log.Printf("[INFO] Reading organization with ID: %s", d.Id())
 
@@ -96,7 +109,7 @@ func resourceLiteLLMOrganizationRead(d *schema.ResourceData, m interface{}) erro
 		"organizations": []string{d.Id()},
 	})
 	if err != nil {
-		return fmt.Errorf("error reading organization: %w", err)
+		return diag.FromErr(fmt.Errorf("error reading organization: %w", err))
 	}
 	defer resp.Body.Close()
 
@@ -108,7 +121,7 @@ func resourceLiteLLMOrganizationRead(d *schema.ResourceData, m interface{}) erro
 
 	var orgResps []OrganizationResponse
 	if err := json.NewDecoder(resp.Body).Decode(&orgResps); err != nil {
-		return fmt.Errorf("error decoding organization info response: %w", err)
+		return diag.FromErr(fmt.Errorf("error decoding organization info response: %w", err))
 	}
 
 	if len(orgResps) == 0 {
@@ -133,38 +146,42 @@ func resourceLiteLLMOrganizationRead(d *schema.ResourceData, m interface{}) erro
 		d.Set("models", d.Get("models"))
 	}
 
-	d.Set("max_budget", GetFloatValue(orgResp.MaxBudget, d.Get("max_budget").(float64)))
+	d.Set("max_budget", orgResp.MaxBudget)
 	d.Set("budget_duration", GetStringValue(orgResp.BudgetDuration, d.Get("budget_duration").(string)))
-	d.Set("tpm_limit", GetIntValue(orgResp.TPMLimit, d.Get("tpm_limit").(int)))
-	d.Set("rpm_limit", GetIntValue(orgResp.RPMLimit, d.Get("rpm_limit").(int)))
-	d.Set("blocked", GetBoolValue(orgResp.Blocked, d.Get("blocked").(bool)))
+	d.Set("tpm_limit", orgResp.TPMLimit)
+	d.Set("rpm_limit", orgResp.RPMLimit)
+	d.Set("blocked", orgResp.Blocked)
+
+	if orgResp.BudgetTable != nil {
+		d.Set("model_limits", flattenModelLimits(flattenOrganizationModelLimits(orgResp.BudgetTable.ModelMaxBudget), true))
+	}
 
 	log.Printf("[INFO] Successfully read organization with ID: %s", d.Id())
 	return nil
 }
 
-func resourceLiteLLMOrganizationUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	orgData := buildOrganizationData(d, d.Id())
 	log.Printf("[DEBUG] Update organization request payload: %+v", orgData)
 
 	resp, err := MakeRequest(client, "POST", endpointOrganizationUpdate, orgData)
 	if err != nil {
-		return fmt.Errorf("error updating organization: %w", err)
+		return diag.FromErr(fmt.Errorf("error updating organization: %w", err))
 	}
 	defer resp.Body.Close()
 
 	if err := handleResponse(resp, "updating organization"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Successfully updated organization with ID: %s", d.Id())
-	return resourceLiteLLMOrganizationRead(d, m)
+	return resourceLiteLLMOrganizationRead(ctx, d, m)
 }
 
-func resourceLiteLLMOrganizationDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	log.Printf("[INFO] Deleting organization with ID: %s", d.Id())
 
@@ -175,12 +192,12 @@ func resourceLiteLLMOrganizationDelete(d *schema.ResourceData, m interface{}) er
 	resp, err := MakeRequest(client, "DELETE", endpointOrganizationDelete, deleteData)
 
 	if err != nil {
-		return fmt.Errorf("error deleting organization: %w", err)
+		return diag.FromErr(fmt.Errorf("error deleting organization: %w", err))
 	}
 	defer resp.Body.Close()
 
 	if err := handleResponse(resp, "deleting organization"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Successfully deleted organization with ID: %s", d.Id())
@@ -194,10 +211,10 @@ func buildOrganizationData(d *schema.ResourceData, orgID string) map[string]inte
 		"organization_alias": d.Get("organization_alias").(string),
 	}
 
-	for _, key := range []string{"metadata", "models", "max_budget", "budget_duration", "tpm_limit", "rpm_limit", "blocked"} {
-		if v, ok := d.GetOk(key); ok {
-			orgData[key] = v
-		}
+	putFields(d, orgData, "metadata", "models", "max_budget", "budget_duration", "tpm_limit", "rpm_limit", "blocked")
+
+	if _, ok := d.GetOk("model_limits"); ok || d.HasChange("model_limits") {
+		orgData["model_max_budget"] = expandOrganizationModelLimits(expandModelLimits(d.Get("model_limits").(*schema.Set), rawConfigValue(d, "model_limits")))
 	}
 
 	return orgData
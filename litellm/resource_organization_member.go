@@ -1,19 +1,22 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceLiteLLMOrganizationMember() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLiteLLMOrganizationMemberCreate,
-		Read:   resourceLiteLLMOrganizationMemberRead,
-		Update: resourceLiteLLMOrganizationMemberUpdate,
-		Delete: resourceLiteLLMOrganizationMemberDelete,
+		CreateContext: resourceLiteLLMOrganizationMemberCreate,
+		ReadContext:   resourceLiteLLMOrganizationMemberRead,
+		UpdateContext: resourceLiteLLMOrganizationMemberUpdate,
+		DeleteContext: resourceLiteLLMOrganizationMemberDelete,
+		Timeouts:      defaultResourceTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
@@ -41,8 +44,8 @@ func resourceLiteLLMOrganizationMember() *schema.Resource {
 	}
 }
 
-func resourceLiteLLMOrganizationMemberCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	memberData := map[string]interface{}{
 		"member": []map[string]interface{}{
@@ -59,7 +62,7 @@ func resourceLiteLLMOrganizationMemberCreate(d *schema.ResourceData, m interface
 
 	resp, err := client.AddOrganizationMember(memberData)
 	if err != nil {
-		return fmt.Errorf("error creating organization member: %v", err)
+		return diag.FromErr(fmt.Errorf("error creating organization member: %v", err))
 	}
 
 	log.Printf("[DEBUG] Create organization member response: %+v", resp)
@@ -69,18 +72,18 @@ func resourceLiteLLMOrganizationMemberCreate(d *schema.ResourceData, m interface
 
 	log.Printf("[INFO] Organization member created with ID: %s", d.Id())
 
-	return resourceLiteLLMOrganizationMemberRead(d, m)
+	return resourceLiteLLMOrganizationMemberRead(ctx, d, m)
 }
 
-func resourceLiteLLMOrganizationMemberRead(d *schema.ResourceData, m interface{}) error {
+func resourceLiteLLMOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// There's no specific endpoint to read a single organization member
 	// We'll just return the data we have in the state
 	log.Printf("[INFO] Reading organization member with ID: %s", d.Id())
 	return nil
 }
 
-func resourceLiteLLMOrganizationMemberUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationMemberUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	updateData := map[string]interface{}{
 		"user_id":         d.Get("user_id").(string),
@@ -93,18 +96,18 @@ func resourceLiteLLMOrganizationMemberUpdate(d *schema.ResourceData, m interface
 
 	resp, err := client.UpdateOrganizationMember(updateData)
 	if err != nil {
-		return fmt.Errorf("error updating organization member: %v", err)
+		return diag.FromErr(fmt.Errorf("error updating organization member: %v", err))
 	}
 
 	log.Printf("[DEBUG] Update organization member response: %+v", resp)
 
 	log.Printf("[INFO] Successfully updated organization member with ID: %s", d.Id())
 
-	return resourceLiteLLMOrganizationMemberRead(d, m)
+	return resourceLiteLLMOrganizationMemberRead(ctx, d, m)
 }
 
-func resourceLiteLLMOrganizationMemberDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	deleteData := map[string]interface{}{
 		"user_id":         d.Get("user_id").(string),
@@ -116,7 +119,7 @@ func resourceLiteLLMOrganizationMemberDelete(d *schema.ResourceData, m interface
 
 	_, err := client.DeleteOrganizationMember(deleteData)
 	if err != nil {
-		return fmt.Errorf("error deleting organization member: %v", err)
+		return diag.FromErr(fmt.Errorf("error deleting organization member: %v", err))
 	}
 
 	log.Printf("[INFO] Successfully deleted organization member with ID: %s", d.Id())
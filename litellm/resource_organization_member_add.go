@@ -1,19 +1,22 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceLiteLLMOrganizationMemberAdd() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLiteLLMOrganizationMemberAddCreate,
-		Read:   resourceLiteLLMOrganizationMemberAddRead,
-		Update: resourceLiteLLMOrganizationMemberAddUpdate,
-		Delete: resourceLiteLLMOrganizationMemberAddDelete,
+		CreateContext: resourceLiteLLMOrganizationMemberAddCreate,
+		ReadContext:   resourceLiteLLMOrganizationMemberAddRead,
+		UpdateContext: resourceLiteLLMOrganizationMemberAddUpdate,
+		DeleteContext: resourceLiteLLMOrganizationMemberAddDelete,
+		Timeouts:      defaultResourceTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
@@ -50,8 +53,8 @@ func resourceLiteLLMOrganizationMemberAdd() *schema.Resource {
 	}
 }
 
-func resourceLiteLLMOrganizationMemberAddCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationMemberAddCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	orgID := d.Get("organization_id").(string)
 	members := d.Get("member").(*schema.Set)
@@ -81,7 +84,7 @@ func resourceLiteLLMOrganizationMemberAddCreate(d *schema.ResourceData, m interf
 
 	resp, err := client.AddOrganizationMember(memberData)
 	if err != nil {
-		return fmt.Errorf("error adding organization members: %v", err)
+		return diag.FromErr(fmt.Errorf("error adding organization members: %v", err))
 	}
 
 	log.Printf("[DEBUG] Create organization members response: %+v", resp)
@@ -89,17 +92,17 @@ func resourceLiteLLMOrganizationMemberAddCreate(d *schema.ResourceData, m interf
 	// Set ID as organization_id since this resource manages all members for an organization
 	d.SetId(orgID)
 
-	return resourceLiteLLMOrganizationMemberAddRead(d, m)
+	return resourceLiteLLMOrganizationMemberAddRead(ctx, d, m)
 }
 
-func resourceLiteLLMOrganizationMemberAddRead(d *schema.ResourceData, m interface{}) error {
+func resourceLiteLLMOrganizationMemberAddRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// The API doesn't provide a way to read specific organization members easily
 	// We'll maintain the state as is
 	return nil
 }
 
-func resourceLiteLLMOrganizationMemberAddUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationMemberAddUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	orgID := d.Get("organization_id").(string)
 
 	o, n := d.GetChange("member")
@@ -145,7 +148,7 @@ func resourceLiteLLMOrganizationMemberAddUpdate(d *schema.ResourceData, m interf
 
 			_, err := client.DeleteOrganizationMember(deleteData)
 			if err != nil {
-				return fmt.Errorf("error deleting organization member: %v", err)
+				return diag.FromErr(fmt.Errorf("error deleting organization member: %v", err))
 			}
 		}
 	}
@@ -170,7 +173,7 @@ func resourceLiteLLMOrganizationMemberAddUpdate(d *schema.ResourceData, m interf
 
 				_, err := client.UpdateOrganizationMember(updateData)
 				if err != nil {
-					return fmt.Errorf("error updating organization member: %v", err)
+					return diag.FromErr(fmt.Errorf("error updating organization member: %v", err))
 				}
 			}
 		}
@@ -203,13 +206,13 @@ func resourceLiteLLMOrganizationMemberAddUpdate(d *schema.ResourceData, m interf
 
 		resp, err := client.AddOrganizationMember(memberData)
 		if err != nil {
-			return fmt.Errorf("error adding organization members: %v", err)
+			return diag.FromErr(fmt.Errorf("error adding organization members: %v", err))
 		}
 
 		log.Printf("[DEBUG] Add organization members response: %+v", resp)
 	}
 
-	return resourceLiteLLMOrganizationMemberAddRead(d, m)
+	return resourceLiteLLMOrganizationMemberAddRead(ctx, d, m)
 }
 
 // getOrgMemberKey returns a unique key for a member based on user_id or user_email
@@ -231,8 +234,8 @@ func orgMemberAttributesChanged(oldMember, newMember map[string]interface{}) boo
 	return oldRole != newRole
 }
 
-func resourceLiteLLMOrganizationMemberAddDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMOrganizationMemberAddDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	orgID := d.Get("organization_id").(string)
 	members := d.Get("member").(*schema.Set)
 
@@ -251,7 +254,7 @@ func resourceLiteLLMOrganizationMemberAddDelete(d *schema.ResourceData, m interf
 
 		_, err := client.DeleteOrganizationMember(deleteData)
 		if err != nil {
-			return fmt.Errorf("error deleting organization member: %v", err)
+			return diag.FromErr(fmt.Errorf("error deleting organization member: %v", err))
 		}
 	}
 
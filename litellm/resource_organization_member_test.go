@@ -10,8 +10,8 @@ import (
 
 func TestAccLiteLLMOrganizationMember_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLiteLLMOrganizationMemberConfig("test-org-member", "test-user-1"),
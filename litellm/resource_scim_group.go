@@ -0,0 +1,204 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointSCIMGroups = "/scim/v2/Groups"
+)
+
+func resourceLiteLLMSCIMGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiteLLMSCIMGroupCreate,
+		ReadContext:   resourceLiteLLMSCIMGroupRead,
+		UpdateContext: resourceLiteLLMSCIMGroupUpdate,
+		DeleteContext: resourceLiteLLMSCIMGroupDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the group, used as the team alias",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Identifier of the group in the identity provider",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of user IDs that are members of the group",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SCIM ID of the group, which is also the ID of the LiteLLM team it maps to",
+			},
+		},
+	}
+}
+
+func resourceLiteLLMSCIMGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	group := SCIMGroup{
+		Schemas:     []string{scimSchemaGroup},
+		DisplayName: d.Get("display_name").(string),
+		ExternalID:  d.Get("external_id").(string),
+	}
+	for _, member := range d.Get("members").(*schema.Set).List() {
+		group.Members = append(group.Members, SCIMMember{Value: member.(string)})
+	}
+
+	log.Printf("[DEBUG] Create SCIM group request payload: %+v", group)
+
+	resp, err := MakeRequest(client, "POST", endpointSCIMGroups, group)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create SCIM group: %w", err))
+	}
+	defer resp.Body.Close()
+
+	var groupResp SCIMGroup
+	if err := handleSCIMAPIResponse(resp, &groupResp, client); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create SCIM group: %w", err))
+	}
+
+	d.SetId(groupResp.ID)
+	log.Printf("[INFO] SCIM group created with ID %s", groupResp.ID)
+
+	return resourceLiteLLMSCIMGroupRead(ctx, d, m)
+}
+
+func resourceLiteLLMSCIMGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s/%s", endpointSCIMGroups, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read SCIM group: %w", err))
+	}
+	defer resp.Body.Close()
+
+	var groupResp SCIMGroup
+	if err := handleSCIMAPIResponse(resp, &groupResp, client); err != nil {
+		if err.Error() == "scim_resource_not_found" {
+			log.Printf("[WARN] SCIM group with ID %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read SCIM group: %w", err))
+	}
+
+	members := make([]string, 0, len(groupResp.Members))
+	for _, member := range groupResp.Members {
+		members = append(members, member.Value)
+	}
+
+	d.Set("group_id", groupResp.ID)
+	d.Set("display_name", groupResp.DisplayName)
+	d.Set("external_id", GetStringValue(groupResp.ExternalID, d.Get("external_id").(string)))
+	d.Set("members", members)
+
+	return nil
+}
+
+func resourceLiteLLMSCIMGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	var operations []SCIMPatchOperation
+
+	if d.HasChange("display_name") {
+		operations = append(operations, scimReplaceOperation("displayName", d.Get("display_name").(string)))
+	}
+	if d.HasChange("external_id") {
+		operations = append(operations, scimReplaceOperation("externalId", d.Get("external_id").(string)))
+	}
+	if d.HasChange("members") {
+		o, n := d.GetChange("members")
+		oldMembers := o.(*schema.Set)
+		newMembers := n.(*schema.Set)
+
+		for _, member := range oldMembers.Difference(newMembers).List() {
+			operations = append(operations, SCIMPatchOperation{
+				Op:   "remove",
+				Path: fmt.Sprintf("members[value eq \"%s\"]", member.(string)),
+			})
+		}
+
+		var added []SCIMMember
+		for _, member := range newMembers.Difference(oldMembers).List() {
+			added = append(added, SCIMMember{Value: member.(string)})
+		}
+		if len(added) > 0 {
+			operations = append(operations, SCIMPatchOperation{
+				Op:    "add",
+				Path:  "members",
+				Value: added,
+			})
+		}
+	}
+
+	if len(operations) > 0 {
+		if err := patchSCIMResource(client, fmt.Sprintf("%s/%s", endpointSCIMGroups, d.Id()), operations); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to update SCIM group: %w", err))
+		}
+		log.Printf("[INFO] SCIM group updated with ID %s", d.Id())
+	}
+
+	return resourceLiteLLMSCIMGroupRead(ctx, d, m)
+}
+
+func resourceLiteLLMSCIMGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	resp, err := MakeRequest(client, "DELETE", fmt.Sprintf("%s/%s", endpointSCIMGroups, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete SCIM group: %w", err))
+	}
+	defer resp.Body.Close()
+
+	if err := handleSCIMAPIResponse(resp, nil, client); err != nil {
+		if err.Error() != "scim_resource_not_found" {
+			return diag.FromErr(fmt.Errorf("failed to delete SCIM group: %w", err))
+		}
+	}
+
+	log.Printf("[INFO] SCIM group deleted with ID %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// patchSCIMResource applies a list of SCIM PATCH operations to a user or group.
+func patchSCIMResource(client *Client, endpoint string, operations []SCIMPatchOperation) error {
+	patch := SCIMPatchOp{
+		Schemas:    []string{scimSchemaPatchOp},
+		Operations: operations,
+	}
+
+	log.Printf("[DEBUG] SCIM patch request payload for %s: %+v", endpoint, patch)
+
+	resp, err := MakeRequest(client, "PATCH", endpoint, patch)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return handleSCIMAPIResponse(resp, nil, client)
+}
+
+// scimReplaceOperation returns a replace operation for a string attribute, or a
+// remove operation when the attribute has been cleared.
+func scimReplaceOperation(path, value string) SCIMPatchOperation {
+	if value == "" {
+		return SCIMPatchOperation{Op: "remove", Path: path}
+	}
+	return SCIMPatchOperation{Op: "replace", Path: path, Value: value}
+}
@@ -0,0 +1,67 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSCIMGroupUpdateMembers(t *testing.T) {
+	var patch SCIMPatchOp
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PATCH":
+			json.NewDecoder(r.Body).Decode(&patch)
+			io.WriteString(w, `{}`)
+		case "GET":
+			io.WriteString(w, `{"id": "g1", "displayName": "ci", "members": [{"value": "u2"}, {"value": "u3"}]}`)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "sk-admin", false)
+
+	r := resourceLiteLLMSCIMGroup()
+	state := &terraform.InstanceState{
+		ID: "g1",
+		Attributes: map[string]string{
+			"id":           "g1",
+			"display_name": "ci",
+			"members.#":    "2",
+		},
+	}
+	hash := schema.HashSchema(r.Schema["members"].Elem.(*schema.Schema))
+	for _, member := range []string{"u1", "u2"} {
+		state.Attributes[fmt.Sprintf("members.%d", hash(member))] = member
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"display_name": "ci",
+		"members":      []interface{}{"u2", "u3"},
+	})
+
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, state, config, client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, diags := r.Apply(ctx, state, diff, client); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	// Members are added and removed individually, unchanged members are left alone
+	want := []SCIMPatchOperation{
+		{Op: "remove", Path: `members[value eq "u1"]`},
+		{Op: "add", Path: "members", Value: []interface{}{map[string]interface{}{"value": "u3"}}},
+	}
+	if !reflect.DeepEqual(patch.Operations, want) {
+		t.Errorf("operations = %#v, want %#v", patch.Operations, want)
+	}
+}
@@ -0,0 +1,234 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointSCIMUsers = "/scim/v2/Users"
+)
+
+func resourceLiteLLMSCIMUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiteLLMSCIMUserCreate,
+		ReadContext:   resourceLiteLLMSCIMUserRead,
+		UpdateContext: resourceLiteLLMSCIMUserUpdate,
+		DeleteContext: resourceLiteLLMSCIMUserDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique user name, typically the user's email address",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Identifier of the user in the identity provider",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Display name of the user",
+			},
+			"given_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Given name of the user",
+			},
+			"family_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Family name of the user",
+			},
+			"emails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Email addresses of the user, the first one is the primary email",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the user is active",
+			},
+			"groups": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of SCIM group IDs the user belongs to, managed through the members of litellm_scim_group",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SCIM ID of the user, which is also the LiteLLM user ID",
+			},
+		},
+	}
+}
+
+func resourceLiteLLMSCIMUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	user := SCIMUser{
+		Schemas:     []string{scimSchemaUser},
+		UserName:    d.Get("user_name").(string),
+		ExternalID:  d.Get("external_id").(string),
+		DisplayName: d.Get("display_name").(string),
+		Active:      d.Get("active").(bool),
+		Emails:      expandSCIMEmails(d.Get("emails").([]interface{})),
+	}
+	if givenName, familyName := d.Get("given_name").(string), d.Get("family_name").(string); givenName != "" || familyName != "" {
+		user.Name = &SCIMName{
+			GivenName:  givenName,
+			FamilyName: familyName,
+		}
+	}
+
+	log.Printf("[DEBUG] Create SCIM user request payload: %+v", user)
+
+	resp, err := MakeRequest(client, "POST", endpointSCIMUsers, user)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create SCIM user: %w", err))
+	}
+	defer resp.Body.Close()
+
+	var userResp SCIMUser
+	if err := handleSCIMAPIResponse(resp, &userResp, client); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create SCIM user: %w", err))
+	}
+
+	d.SetId(userResp.ID)
+	log.Printf("[INFO] SCIM user created with ID %s", userResp.ID)
+
+	return resourceLiteLLMSCIMUserRead(ctx, d, m)
+}
+
+func resourceLiteLLMSCIMUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s/%s", endpointSCIMUsers, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read SCIM user: %w", err))
+	}
+	defer resp.Body.Close()
+
+	var userResp SCIMUser
+	if err := handleSCIMAPIResponse(resp, &userResp, client); err != nil {
+		if err.Error() == "scim_resource_not_found" {
+			log.Printf("[WARN] SCIM user with ID %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read SCIM user: %w", err))
+	}
+
+	d.Set("user_id", userResp.ID)
+	d.Set("user_name", GetStringValue(userResp.UserName, d.Get("user_name").(string)))
+	d.Set("external_id", GetStringValue(userResp.ExternalID, d.Get("external_id").(string)))
+	d.Set("display_name", GetStringValue(userResp.DisplayName, d.Get("display_name").(string)))
+	d.Set("active", userResp.Active)
+
+	if userResp.Name != nil {
+		d.Set("given_name", GetStringValue(userResp.Name.GivenName, d.Get("given_name").(string)))
+		d.Set("family_name", GetStringValue(userResp.Name.FamilyName, d.Get("family_name").(string)))
+	}
+
+	emails := make([]string, 0, len(userResp.Emails))
+	for _, email := range userResp.Emails {
+		emails = append(emails, email.Value)
+	}
+	d.Set("emails", emails)
+
+	groups := make([]string, 0, len(userResp.Groups))
+	for _, group := range userResp.Groups {
+		groups = append(groups, group.Value)
+	}
+	d.Set("groups", groups)
+
+	return nil
+}
+
+func resourceLiteLLMSCIMUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	var operations []SCIMPatchOperation
+
+	if d.HasChange("user_name") {
+		operations = append(operations, scimReplaceOperation("userName", d.Get("user_name").(string)))
+	}
+	if d.HasChange("external_id") {
+		operations = append(operations, scimReplaceOperation("externalId", d.Get("external_id").(string)))
+	}
+	if d.HasChange("display_name") {
+		operations = append(operations, scimReplaceOperation("displayName", d.Get("display_name").(string)))
+	}
+	if d.HasChange("given_name") {
+		operations = append(operations, scimReplaceOperation("name.givenName", d.Get("given_name").(string)))
+	}
+	if d.HasChange("family_name") {
+		operations = append(operations, scimReplaceOperation("name.familyName", d.Get("family_name").(string)))
+	}
+	if d.HasChange("active") {
+		operations = append(operations, SCIMPatchOperation{
+			Op:    "replace",
+			Path:  "active",
+			Value: d.Get("active").(bool),
+		})
+	}
+	if d.HasChange("emails") {
+		operations = append(operations, SCIMPatchOperation{
+			Op:    "replace",
+			Path:  "emails",
+			Value: expandSCIMEmails(d.Get("emails").([]interface{})),
+		})
+	}
+	if len(operations) > 0 {
+		if err := patchSCIMResource(client, fmt.Sprintf("%s/%s", endpointSCIMUsers, d.Id()), operations); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to update SCIM user: %w", err))
+		}
+		log.Printf("[INFO] SCIM user updated with ID %s", d.Id())
+	}
+
+	return resourceLiteLLMSCIMUserRead(ctx, d, m)
+}
+
+func resourceLiteLLMSCIMUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	resp, err := MakeRequest(client, "DELETE", fmt.Sprintf("%s/%s", endpointSCIMUsers, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete SCIM user: %w", err))
+	}
+	defer resp.Body.Close()
+
+	if err := handleSCIMAPIResponse(resp, nil, client); err != nil {
+		if err.Error() != "scim_resource_not_found" {
+			return diag.FromErr(fmt.Errorf("failed to delete SCIM user: %w", err))
+		}
+	}
+
+	log.Printf("[INFO] SCIM user deleted with ID %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// expandSCIMEmails converts a list of email addresses into SCIM emails, marking the first as primary.
+func expandSCIMEmails(list []interface{}) []SCIMEmail {
+	emails := make([]SCIMEmail, 0, len(list))
+	for i, email := range list {
+		emails = append(emails, SCIMEmail{
+			Value:   email.(string),
+			Type:    "work",
+			Primary: i == 0,
+		})
+	}
+	return emails
+}
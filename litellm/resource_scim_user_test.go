@@ -0,0 +1,93 @@
+package litellm
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestHandleSCIMAPIResponse(t *testing.T) {
+	client := NewClient("http://litellm.invalid", "sk-admin", false)
+
+	for _, tc := range []struct {
+		status  int
+		body    string
+		wantErr string
+	}{
+		{http.StatusOK, `{"id": "u1", "userName": "ci@example.com"}`, ""},
+		{http.StatusCreated, `{"id": "u1", "userName": "ci@example.com"}`, ""},
+		{http.StatusNoContent, ``, ""},
+		{http.StatusNotFound, `{"detail": "User not found"}`, "scim_resource_not_found"},
+		{http.StatusConflict, `{"detail": "User already exists"}`, "User already exists"},
+		{http.StatusOK, `not json`, "failed to parse response"},
+	} {
+		resp := &http.Response{
+			Status:     http.StatusText(tc.status),
+			StatusCode: tc.status,
+			Body:       io.NopCloser(strings.NewReader(tc.body)),
+		}
+		var user SCIMUser
+		err := handleSCIMAPIResponse(resp, &user, client)
+		if tc.wantErr == "" {
+			if err != nil {
+				t.Errorf("%d %s: err: %s", tc.status, tc.body, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("%d %s: err = %v, want %q", tc.status, tc.body, err, tc.wantErr)
+		}
+	}
+}
+
+func TestSCIMUserGroupsComputed(t *testing.T) {
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == endpointSCIMUsers:
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"id": "u1", "userName": "ci@example.com", "active": true}`)
+		case r.Method == "GET" && r.URL.Path == endpointSCIMUsers+"/u1":
+			// Group memberships are added through the group
+			io.WriteString(w, `{"id": "u1", "userName": "ci@example.com", "active": true, "groups": [{"value": "g1"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "sk-admin", false)
+
+	r := resourceLiteLLMSCIMUser()
+	if s := r.Schema["groups"]; s.Optional || !s.Computed {
+		t.Fatal("groups is configurable, which conflicts with the members of litellm_scim_group")
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"user_name": "ci@example.com"})
+	if diags := resourceLiteLLMSCIMUserCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if _, ok := created["groups"]; ok {
+		t.Errorf("create sent groups: %v", created)
+	}
+	if got := d.Get("groups").(*schema.Set).List(); !reflect.DeepEqual(got, []interface{}{"g1"}) {
+		t.Errorf("groups = %v, want [g1]", got)
+	}
+
+	// A user deleted on the proxy is removed from state
+	d.SetId("deleted")
+	if diags := resourceLiteLLMSCIMUserRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want the user removed from state", d.Id())
+	}
+}
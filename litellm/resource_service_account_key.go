@@ -0,0 +1,41 @@
+package litellm
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// NewServiceAccountKeyResource returns the litellm_service_account_key resource.
+// It shares the litellm_key schema, but the key is owned by a team so that it
+// survives when the user who created it leaves.
+func NewServiceAccountKeyResource() resource.Resource {
+	return &keyResource{serviceAccount: true}
+}
+
+// serviceAccountKeySchema adapts the litellm_key schema to service account keys.
+func serviceAccountKeySchema(s *schema.Schema) {
+	s.Attributes["team_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Team that owns the service account key",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	// The service account endpoint ignores user_id
+	s.Attributes["user_id"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	s.Attributes["service_account_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Identifier of the service account, stored in the key metadata. Defaults to the key alias",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package litellm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getSettings retrieves the current values of a proxy-wide settings endpoint.
+func getSettings(client *Client, endpoint string) (map[string]interface{}, error) {
+	log.Printf("[INFO] Reading settings from %s", endpoint)
+
+	resp, err := MakeRequest(client, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading settings: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := handleResponse(resp, "reading settings"); err != nil {
+		return nil, err
+	}
+
+	var settingsResp SettingsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&settingsResp); err != nil {
+		return nil, fmt.Errorf("error decoding settings response: %w", err)
+	}
+
+	if settingsResp.Values == nil {
+		return make(map[string]interface{}), nil
+	}
+
+	return settingsResp.Values, nil
+}
+
+// updateSettings sends a partial update to a proxy-wide settings endpoint.
+func updateSettings(client *Client, endpoint string, payload map[string]interface{}) error {
+	payloadJSON, _ := json.Marshal(payload)
+	log.Printf("[DEBUG] Update settings request payload for %s: %s", endpoint, client.redactSensitiveData(string(payloadJSON)))
+
+	resp, err := MakeRequest(client, "PATCH", endpoint, payload)
+	if err != nil {
+		return fmt.Errorf("error updating settings: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return handleResponse(resp, "updating settings")
+}
+
+// mergeSettings overlays the configured fields onto the current settings values,
+// so that settings not managed by Terraform are written back unchanged. The fields
+// are added with putFields: configured zero values are sent and removed fields are
+// cleared.
+func mergeSettings(d *schema.ResourceData, current map[string]interface{}, fields []string) map[string]interface{} {
+	payload := make(map[string]interface{})
+
+	for _, field := range fields {
+		if v, ok := current[field]; ok && v != nil {
+			payload[field] = v
+		}
+	}
+	putFields(d, payload, fields...)
+
+	return payload
+}
+
+// setSettingsFields updates the state with the values returned by a settings
+// endpoint. A field returned as null is cleared, a missing field is kept.
+func setSettingsFields(d *schema.ResourceData, values map[string]interface{}, fields []string) error {
+	for _, field := range fields {
+		v, ok := values[field]
+		if !ok {
+			continue
+		}
+		if err := d.Set(field, v); err != nil {
+			return fmt.Errorf("error setting %s: %s", field, err)
+		}
+	}
+
+	return nil
+}
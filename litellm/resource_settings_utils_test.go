@@ -0,0 +1,110 @@
+package litellm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMergeSettingsCreate(t *testing.T) {
+	current := map[string]interface{}{
+		"models":          []interface{}{"gpt-4o"},
+		"max_budget":      50.0,
+		"budget_duration": "30d",
+		"tpm_limit":       nil,
+	}
+	payload := testApplyRequest(t, resourceLiteLLMDefaultTeamSettings().Schema, nil,
+		`{"tpm_limit": 0, "max_budget": 0}`,
+		func(d *schema.ResourceData) map[string]interface{} {
+			return mergeSettings(d, current, defaultTeamSettingsFields)
+		})
+
+	// Explicit zero values are sent and the other settings are written back
+	want := map[string]interface{}{
+		"models":          []interface{}{"gpt-4o"},
+		"max_budget":      0.0,
+		"budget_duration": "30d",
+		"tpm_limit":       0,
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("got %#v, want %#v", payload, want)
+	}
+}
+
+func TestMergeSettingsUpdate(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: defaultTeamSettingsID,
+		Attributes: map[string]string{
+			"id":         defaultTeamSettingsID,
+			"models.#":   "1",
+			"models.0":   "gpt-4o",
+			"max_budget": "50",
+			"rpm_limit":  "10",
+		},
+	}
+	current := map[string]interface{}{
+		"models":     []interface{}{"gpt-4o"},
+		"max_budget": 50.0,
+		"rpm_limit":  10,
+		"team_extra": "kept",
+	}
+	payload := testApplyRequest(t, resourceLiteLLMDefaultTeamSettings().Schema, state,
+		`{"rpm_limit": 20}`,
+		func(d *schema.ResourceData) map[string]interface{} {
+			return mergeSettings(d, current, defaultTeamSettingsFields)
+		})
+
+	// Removed fields are cleared even though they are computed
+	want := map[string]interface{}{
+		"models":     []interface{}{},
+		"max_budget": nil,
+		"rpm_limit":  20,
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("got %#v, want %#v", payload, want)
+	}
+}
+
+func TestMergeSettingsFalse(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"enabled": {Type: schema.TypeBool, Optional: true, Computed: true},
+	}
+	payload := testApplyRequest(t, s, nil, `{"enabled": false}`,
+		func(d *schema.ResourceData) map[string]interface{} {
+			return mergeSettings(d, map[string]interface{}{"enabled": true}, []string{"enabled"})
+		})
+
+	if want := map[string]interface{}{"enabled": false}; !reflect.DeepEqual(payload, want) {
+		t.Errorf("got %#v, want %#v", payload, want)
+	}
+}
+
+func TestWriteSSOSettingsRemoved(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: ssoSettingsID,
+		Attributes: map[string]string{
+			"id":                                    ssoSettingsID,
+			"google_client_id":                      "client",
+			"google_client_secret":                  "secret",
+			"ui_access_mode.#":                      "1",
+			"ui_access_mode.0.restricted_sso_group": "admins",
+			"ui_access_mode.0.sso_group_jwt_field":  "groups",
+		},
+	}
+	payload := testApplyRequest(t, resourceLiteLLMSSOSettings().Schema, state,
+		`{"google_client_id": "client", "ui_access_mode": []}`,
+		func(d *schema.ResourceData) map[string]interface{} {
+			return ssoSettingsPayload(d, map[string]interface{}{"google_client_id": "client"})
+		})
+
+	want := map[string]interface{}{
+		"google_client_id":     "client",
+		"google_client_secret": nil,
+		"ui_access_mode":       nil,
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("got %#v, want %#v", payload, want)
+	}
+}
@@ -0,0 +1,249 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointSSOSettingsGet    = "/get/sso_settings"
+	endpointSSOSettingsUpdate = "/update/sso_settings"
+
+	ssoSettingsID = "sso_settings"
+)
+
+// ssoSettingsFields are the SSO settings that are read back from the API.
+var ssoSettingsFields = []string{
+	"google_client_id",
+	"microsoft_client_id",
+	"microsoft_tenant",
+	"generic_client_id",
+	"generic_authorization_endpoint",
+	"generic_token_endpoint",
+	"generic_userinfo_endpoint",
+	"proxy_base_url",
+	"user_email",
+}
+
+// ssoSettingsSecretFields are never read back from the API and are only sent when configured.
+var ssoSettingsSecretFields = []string{
+	"google_client_secret",
+	"microsoft_client_secret",
+	"generic_client_secret",
+}
+
+func resourceLiteLLMSSOSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiteLLMSSOSettingsCreate,
+		ReadContext:   resourceLiteLLMSSOSettingsRead,
+		UpdateContext: resourceLiteLLMSSOSettingsUpdate,
+		DeleteContext: resourceLiteLLMSSOSettingsDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			"google_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Google OAuth client ID",
+			},
+			"google_client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Google OAuth client secret",
+			},
+			"microsoft_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Microsoft Entra ID client ID",
+			},
+			"microsoft_client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Microsoft Entra ID client secret",
+			},
+			"microsoft_tenant": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Microsoft Entra ID tenant ID",
+			},
+			"generic_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client ID for a generic OIDC provider",
+			},
+			"generic_client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Client secret for a generic OIDC provider",
+			},
+			"generic_authorization_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Authorization endpoint for a generic OIDC provider",
+			},
+			"generic_token_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Token endpoint for a generic OIDC provider",
+			},
+			"generic_userinfo_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Userinfo endpoint for a generic OIDC provider",
+			},
+			"proxy_base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Public base URL of the proxy, used to build the SSO callback URL",
+			},
+			"user_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Email of the proxy admin user",
+			},
+			"ui_access_mode": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Restrict UI access to members of an SSO group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"restricted_sso_group": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "SSO group whose members may access the UI",
+						},
+						"sso_group_jwt_field": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "JWT field containing the user's SSO groups",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLiteLLMSSOSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := writeSSOSettings(ctx, d, m); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating SSO settings: %w", err))
+	}
+
+	d.SetId(ssoSettingsID)
+	log.Printf("[INFO] SSO settings created")
+
+	return resourceLiteLLMSSOSettingsRead(ctx, d, m)
+}
+
+func resourceLiteLLMSSOSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	values, err := getSettings(client, endpointSSOSettingsGet)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading SSO settings: %w", err))
+	}
+
+	if err := setSettingsFields(d, values, ssoSettingsFields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Client secrets are not read back from the API, we keep what is in state
+	if mode, ok := values["ui_access_mode"].(map[string]interface{}); ok {
+		d.Set("ui_access_mode", []interface{}{
+			map[string]interface{}{
+				"restricted_sso_group": mode["restricted_sso_group"],
+				"sso_group_jwt_field":  mode["sso_group_jwt_field"],
+			},
+		})
+	} else {
+		d.Set("ui_access_mode", nil)
+	}
+
+	return nil
+}
+
+func resourceLiteLLMSSOSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := writeSSOSettings(ctx, d, m); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating SSO settings: %w", err))
+	}
+
+	log.Printf("[INFO] SSO settings updated")
+	return resourceLiteLLMSSOSettingsRead(ctx, d, m)
+}
+
+func resourceLiteLLMSSOSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	// Restore the defaults by clearing every SSO setting
+	payload := map[string]interface{}{
+		"ui_access_mode": nil,
+	}
+	for _, field := range append(ssoSettingsFields, ssoSettingsSecretFields...) {
+		payload[field] = nil
+	}
+
+	if err := updateSettings(client, endpointSSOSettingsUpdate, payload); err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting SSO settings: %w", err))
+	}
+
+	log.Printf("[INFO] SSO settings reset to defaults")
+	d.SetId("")
+	return nil
+}
+
+// writeSSOSettings performs a read-modify-write of the SSO settings.
+func writeSSOSettings(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client).withContext(ctx)
+
+	current, err := getSettings(client, endpointSSOSettingsGet)
+	if err != nil {
+		return err
+	}
+
+	return updateSettings(client, endpointSSOSettingsUpdate, ssoSettingsPayload(d, current))
+}
+
+// ssoSettingsPayload merges the configured SSO settings, including the client
+// secrets and the UI access mode, onto the current values.
+func ssoSettingsPayload(d *schema.ResourceData, current map[string]interface{}) map[string]interface{} {
+	payload := mergeSettings(d, current, ssoSettingsFields)
+
+	for _, field := range ssoSettingsSecretFields {
+		switch {
+		case fieldConfigured(d, field):
+			payload[field] = d.Get(field).(string)
+		case fieldRemoved(d, field):
+			payload[field] = nil
+		}
+	}
+
+	if modes := d.Get("ui_access_mode").([]interface{}); len(modes) > 0 && modes[0] != nil {
+		mode := modes[0].(map[string]interface{})
+		payload["ui_access_mode"] = map[string]interface{}{
+			"type":                 "restricted_sso_group",
+			"restricted_sso_group": mode["restricted_sso_group"].(string),
+			"sso_group_jwt_field":  mode["sso_group_jwt_field"].(string),
+		}
+	} else if blockRemoved(d, "ui_access_mode") {
+		payload["ui_access_mode"] = nil
+	}
+
+	return payload
+}
@@ -1,6 +1,7 @@
 package litellm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -8,6 +9,8 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,10 +25,15 @@ const (
 
 func ResourceLiteLLMTeam() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLiteLLMTeamCreate,
-		Read:   resourceLiteLLMTeamRead,
-		Update: resourceLiteLLMTeamUpdate,
-		Delete: resourceLiteLLMTeamDelete,
+		CreateContext: resourceLiteLLMTeamCreate,
+		ReadContext:   resourceLiteLLMTeamRead,
+		UpdateContext: resourceLiteLLMTeamUpdate,
+		DeleteContext: resourceLiteLLMTeamDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"team_alias": {
@@ -72,12 +80,53 @@ func ResourceLiteLLMTeam() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of permissions granted to team members",
 			},
+			"guardrails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Guardrails applied to requests made by the team",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags used for tag-based routing and spend tracking",
+			},
+			"team_member_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum budget of each team member",
+			},
+			"team_member_key_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateKeyDuration,
+				Description:  "Default duration of keys generated by team members, e.g. 30d",
+			},
+			"model_aliases": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of model aliases available to the team",
+			},
+			"max_parallel_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of parallel requests for the team",
+			},
+			"object_permission": objectPermissionSchema(),
+			"model_limits":      modelLimitsSchema(false),
 		},
+
+		CustomizeDiff: customdiff.All(
+			validateModelNames,
+			validateObjectPermission,
+		),
 	}
 }
 
-func resourceLiteLLMTeamCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMTeamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	teamID := uuid.New().String()
 	teamData := buildTeamData(d, teamID)
@@ -86,56 +135,72 @@ func resourceLiteLLMTeamCreate(d *schema.ResourceData, m interface{}) error {
 
 	resp, err := MakeRequest(client, "POST", endpointTeamNew, teamData)
 	if err != nil {
-		return fmt.Errorf("error creating team: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating team: %w", err))
 	}
 	defer resp.Body.Close()
 
 	if err := handleResponse(resp, "creating team"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(teamID)
 	log.Printf("[INFO] Team created with ID: %s", teamID)
 
-	return resourceLiteLLMTeamRead(d, m)
+	return resourceLiteLLMTeamRead(ctx, d, m)
 }
 
-func resourceLiteLLMTeamRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	log.Printf("[INFO] Reading team with ID: %s", d.Id())
 
-	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?team_id=%s", endpointTeamInfo, d.Id()), nil)
-	if err != nil {
-		return fmt.Errorf("error reading team: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		log.Printf("[WARN] Team with ID %s not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-
-	var teamResp TeamResponse
-	if err := json.NewDecoder(resp.Body).Decode(&teamResp); err != nil {
-		return fmt.Errorf("error decoding team info response: %w", err)
+	teamResp, cached := client.cachedTeam(d.Id())
+	if !cached {
+		var err error
+		teamResp, err = getTeamInfo(client, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if teamResp == nil {
+			log.Printf("[WARN] Team with ID %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 	}
 
 	// Update the state with values from the response or fall back to the data passed in during creation
 	d.Set("team_alias", GetStringValue(teamResp.TeamAlias, d.Get("team_alias").(string)))
 	d.Set("organization_id", GetStringValue(teamResp.OrganizationID, d.Get("organization_id").(string)))
 
-	// Handle metadata separately as it's a map
+	// Handle metadata separately as it's a map, per-model limits and some team settings are stored in it
 	if teamResp.Metadata != nil {
-		d.Set("metadata", teamResp.Metadata)
+		modelLimits, metadata := flattenTeamModelLimits(teamResp.Metadata)
+		d.Set("metadata", flattenTeamMetadataSettings(teamResp, metadata))
+		d.Set("model_limits", flattenModelLimits(modelLimits, false))
 	} else {
 		d.Set("metadata", d.Get("metadata"))
 	}
 
-	d.Set("tpm_limit", GetIntValue(teamResp.TPMLimit, d.Get("tpm_limit").(int)))
-	d.Set("rpm_limit", GetIntValue(teamResp.RPMLimit, d.Get("rpm_limit").(int)))
-	d.Set("max_budget", GetFloatValue(teamResp.MaxBudget, d.Get("max_budget").(float64)))
+	d.Set("guardrails", teamResp.Guardrails)
+	d.Set("tags", teamResp.Tags)
+	d.Set("team_member_key_duration", teamResp.TeamMemberKeyDuration)
+	d.Set("max_parallel_requests", teamResp.MaxParallelRequests)
+	if teamResp.TeamMemberBudgetTable != nil {
+		d.Set("team_member_budget", teamResp.TeamMemberBudgetTable.MaxBudget)
+	} else {
+		d.Set("team_member_budget", teamResp.TeamMemberBudget)
+	}
+	if teamResp.ModelTable != nil {
+		d.Set("model_aliases", teamResp.ModelTable.ModelAliases)
+	} else {
+		d.Set("model_aliases", nil)
+	}
+	d.Set("object_permission", flattenObjectPermission(teamResp.ObjectPermission))
+
+	// Zero limits are read back as they are, a null limit reads as 0 like an unset attribute
+	d.Set("tpm_limit", teamResp.TPMLimit)
+	d.Set("rpm_limit", teamResp.RPMLimit)
+	d.Set("max_budget", teamResp.MaxBudget)
 	d.Set("budget_duration", GetStringValue(teamResp.BudgetDuration, d.Get("budget_duration").(string)))
 
 	// Handle models separately as it's a list
@@ -145,11 +210,12 @@ func resourceLiteLLMTeamRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("models", d.Get("models"))
 	}
 
-	d.Set("blocked", GetBoolValue(teamResp.Blocked, d.Get("blocked").(bool)))
+	d.Set("blocked", teamResp.Blocked)
 
-	// Explicitly fetch the current permissions from the API
-	permResp, err := getTeamPermissions(client, d.Id())
-	if err != nil {
+	// A listed team carries its permissions, otherwise they are fetched from the API
+	if cached && teamResp.TeamMemberPermissions != nil {
+		d.Set("team_member_permissions", teamResp.TeamMemberPermissions)
+	} else if permResp, err := getTeamPermissions(client, d.Id()); err != nil {
 		log.Printf("[WARN] Error fetching team permissions: %s", err)
 		// Fall back to the permissions from the team info response
 		if teamResp.TeamMemberPermissions != nil {
@@ -167,20 +233,20 @@ func resourceLiteLLMTeamRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceLiteLLMTeamUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	teamData := buildTeamData(d, d.Id())
 	log.Printf("[DEBUG] Update team request payload: %+v", teamData)
 
 	resp, err := MakeRequest(client, "POST", endpointTeamUpdate, teamData)
 	if err != nil {
-		return fmt.Errorf("error updating team: %w", err)
+		return diag.FromErr(fmt.Errorf("error updating team: %w", err))
 	}
 	defer resp.Body.Close()
 
 	if err := handleResponse(resp, "updating team"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Check if team_member_permissions have changed and explicitly update them
@@ -195,17 +261,17 @@ func resourceLiteLLMTeamUpdate(d *schema.ResourceData, m interface{}) error {
 
 			log.Printf("[DEBUG] Explicitly updating team permissions: %+v", permissions)
 			if err := updateTeamPermissions(client, d.Id(), permissions); err != nil {
-				return fmt.Errorf("error updating team permissions: %w", err)
+				return diag.FromErr(fmt.Errorf("error updating team permissions: %w", err))
 			}
 		}
 	}
 
 	log.Printf("[INFO] Successfully updated team with ID: %s", d.Id())
-	return resourceLiteLLMTeamRead(d, m)
+	return resourceLiteLLMTeamRead(ctx, d, m)
 }
 
-func resourceLiteLLMTeamDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMTeamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	log.Printf("[INFO] Deleting team with ID: %s", d.Id())
 
@@ -215,12 +281,12 @@ func resourceLiteLLMTeamDelete(d *schema.ResourceData, m interface{}) error {
 
 	resp, err := MakeRequest(client, "POST", endpointTeamDelete, deleteData)
 	if err != nil {
-		return fmt.Errorf("error deleting team: %w", err)
+		return diag.FromErr(fmt.Errorf("error deleting team: %w", err))
 	}
 	defer resp.Body.Close()
 
 	if err := handleResponse(resp, "deleting team"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Successfully deleted team with ID: %s", d.Id())
@@ -234,15 +300,115 @@ func buildTeamData(d *schema.ResourceData, teamID string) map[string]interface{}
 		"team_alias": d.Get("team_alias").(string),
 	}
 
-	for _, key := range []string{"organization_id", "metadata", "tpm_limit", "rpm_limit", "max_budget", "budget_duration", "models", "blocked", "team_member_permissions"} {
-		if v, ok := d.GetOk(key); ok {
-			teamData[key] = v
-		}
+	putFields(d, teamData, "organization_id", "metadata", "tpm_limit", "rpm_limit", "max_budget", "budget_duration", "models", "blocked", "team_member_permissions",
+		"guardrails", "tags", "team_member_budget", "team_member_key_duration", "model_aliases", "max_parallel_requests")
+
+	// Per-model limits are stored in the metadata, which is sent whenever they change
+	if _, ok := d.GetOk("model_limits"); ok || d.HasChange("model_limits") {
+		modelLimits := expandModelLimits(d.Get("model_limits").(*schema.Set), rawConfigValue(d, "model_limits"))
+		teamData["metadata"] = expandTeamModelLimits(modelLimits, d.Get("metadata").(map[string]interface{}))
+	}
+
+	if objectPermission, ok := expandObjectPermission(d); ok {
+		teamData["object_permission"] = objectPermission
 	}
 
 	return teamData
 }
 
+// teamMetadataSettings are team settings that the proxy stores in the team metadata.
+var teamMetadataSettings = []string{"guardrails", "tags", "team_member_key_duration", "team_member_budget_id"}
+
+// getTeamInfo reads a team from /team/info. It returns nil when the team does not exist.
+func getTeamInfo(client *Client, teamID string) (*TeamResponse, error) {
+	resp, err := MakeRequest(client, "GET", fmt.Sprintf("%s?team_id=%s", endpointTeamInfo, teamID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading team: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading team info response: %w", err)
+	}
+
+	teamResp, err := decodeTeamInfoResponse(body)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding team info response: %w", err)
+	}
+	return teamResp, nil
+}
+
+// teamListFields are the team fields that /team/info returns but /team/list
+// leaves out depending on the proxy version: the model aliases, object
+// permissions and member budget.
+var teamListFields = []string{"litellm_model_table", "object_permission", "team_member_budget_table"}
+
+// teamListResponseComplete reports whether a team from /team/list holds every
+// field of teamListFields. A team missing one of them is read from /team/info,
+// so that settings made outside of Terraform are still detected.
+func teamListResponseComplete(fields map[string]json.RawMessage) bool {
+	for _, field := range teamListFields {
+		if _, ok := fields[field]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeTeamInfoResponse decodes a /team/info response, which nests the team
+// under team_info in current proxy versions.
+func decodeTeamInfoResponse(body []byte) (*TeamResponse, error) {
+	var infoResp TeamInfoResponse
+	if err := json.Unmarshal(body, &infoResp); err == nil && infoResp.TeamInfo != nil {
+		return infoResp.TeamInfo, nil
+	}
+
+	var teamResp TeamResponse
+	if err := json.Unmarshal(body, &teamResp); err != nil {
+		return nil, err
+	}
+	return &teamResp, nil
+}
+
+// flattenTeamMetadataSettings moves the team settings stored in the metadata onto
+// the team and returns the remaining metadata.
+func flattenTeamMetadataSettings(teamResp *TeamResponse, metadata map[string]interface{}) map[string]interface{} {
+	if teamResp.Guardrails == nil {
+		teamResp.Guardrails = interfaceToStringList(metadata["guardrails"])
+	}
+	if teamResp.Tags == nil {
+		teamResp.Tags = interfaceToStringList(metadata["tags"])
+	}
+	if s, ok := metadata["team_member_key_duration"].(string); ok && teamResp.TeamMemberKeyDuration == "" {
+		teamResp.TeamMemberKeyDuration = s
+	}
+
+	for _, key := range teamMetadataSettings {
+		delete(metadata, key)
+	}
+	return metadata
+}
+
+// interfaceToStringList converts a JSON array into a list of strings.
+func interfaceToStringList(v interface{}) []string {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
 func handleResponse(resp *http.Response, action string) error {
 	if resp.StatusCode != http.StatusOK {
 		body, _ := ioutil.ReadAll(resp.Body)
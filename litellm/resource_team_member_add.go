@@ -1,19 +1,22 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceLiteLLMTeamMemberAdd() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLiteLLMTeamMemberAddCreate,
-		Read:   resourceLiteLLMTeamMemberAddRead,
-		Update: resourceLiteLLMTeamMemberAddUpdate,
-		Delete: resourceLiteLLMTeamMemberAddDelete,
+		CreateContext: resourceLiteLLMTeamMemberAddCreate,
+		ReadContext:   resourceLiteLLMTeamMemberAddRead,
+		UpdateContext: resourceLiteLLMTeamMemberAddUpdate,
+		DeleteContext: resourceLiteLLMTeamMemberAddDelete,
+		Timeouts:      defaultResourceTimeouts(),
 
 		Schema: map[string]*schema.Schema{
 			"team_id": {
@@ -53,8 +56,8 @@ func resourceLiteLLMTeamMemberAdd() *schema.Resource {
 	}
 }
 
-func resourceLiteLLMTeamMemberAddCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMTeamMemberAddCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	teamID := d.Get("team_id").(string)
 	members := d.Get("member").(*schema.Set)
@@ -86,28 +89,28 @@ func resourceLiteLLMTeamMemberAddCreate(d *schema.ResourceData, m interface{}) e
 
 	resp, err := MakeRequest(client, "POST", "/team/member_add", memberData)
 	if err != nil {
-		return fmt.Errorf("error adding team members: %v", err)
+		return diag.FromErr(fmt.Errorf("error adding team members: %v", err))
 	}
 	defer resp.Body.Close()
 
 	if err := handleResponse(resp, "adding team members"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Set ID as team_id since this resource manages all members for a team
 	d.SetId(teamID)
 
-	return resourceLiteLLMTeamMemberAddRead(d, m)
+	return resourceLiteLLMTeamMemberAddRead(ctx, d, m)
 }
 
-func resourceLiteLLMTeamMemberAddRead(d *schema.ResourceData, m interface{}) error {
+func resourceLiteLLMTeamMemberAddRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// The API doesn't provide a way to read specific team members
 	// We'll maintain the state as is
 	return nil
 }
 
-func resourceLiteLLMTeamMemberAddUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMTeamMemberAddUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	teamID := d.Get("team_id").(string)
 	maxBudget := d.Get("max_budget_in_team").(float64)
 
@@ -163,12 +166,12 @@ func resourceLiteLLMTeamMemberAddUpdate(d *schema.ResourceData, m interface{}) e
 
 				resp, err := MakeRequest(client, "POST", "/team/member_update", updateData)
 				if err != nil {
-					return fmt.Errorf("error updating team member budget: %v", err)
+					return diag.FromErr(fmt.Errorf("error updating team member budget: %v", err))
 				}
 				defer resp.Body.Close()
 
 				if err := handleResponse(resp, "updating team member budget"); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				// Mark this member as updated
@@ -194,12 +197,12 @@ func resourceLiteLLMTeamMemberAddUpdate(d *schema.ResourceData, m interface{}) e
 
 			resp, err := MakeRequest(client, "POST", "/team/member_delete", deleteData)
 			if err != nil {
-				return fmt.Errorf("error deleting team member: %v", err)
+				return diag.FromErr(fmt.Errorf("error deleting team member: %v", err))
 			}
 			defer resp.Body.Close()
 
 			if err := handleResponse(resp, "deleting team member"); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -231,12 +234,12 @@ func resourceLiteLLMTeamMemberAddUpdate(d *schema.ResourceData, m interface{}) e
 
 				resp, err := MakeRequest(client, "POST", "/team/member_update", updateData)
 				if err != nil {
-					return fmt.Errorf("error updating team member: %v", err)
+					return diag.FromErr(fmt.Errorf("error updating team member: %v", err))
 				}
 				defer resp.Body.Close()
 
 				if err := handleResponse(resp, "updating team member"); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -270,16 +273,16 @@ func resourceLiteLLMTeamMemberAddUpdate(d *schema.ResourceData, m interface{}) e
 
 		resp, err := MakeRequest(client, "POST", "/team/member_add", memberData)
 		if err != nil {
-			return fmt.Errorf("error adding team members: %v", err)
+			return diag.FromErr(fmt.Errorf("error adding team members: %v", err))
 		}
 		defer resp.Body.Close()
 
 		if err := handleResponse(resp, "adding team members"); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceLiteLLMTeamMemberAddRead(d, m)
+	return resourceLiteLLMTeamMemberAddRead(ctx, d, m)
 }
 
 // getMemberKey returns a unique key for a member based on user_id or user_email
@@ -308,8 +311,8 @@ func memberAttributesChanged(oldMember, newMember map[string]interface{}) bool {
 	return false
 }
 
-func resourceLiteLLMTeamMemberAddDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMTeamMemberAddDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	teamID := d.Get("team_id").(string)
 	members := d.Get("member").(*schema.Set)
 
@@ -328,12 +331,12 @@ func resourceLiteLLMTeamMemberAddDelete(d *schema.ResourceData, m interface{}) e
 
 		resp, err := MakeRequest(client, "POST", "/team/member_delete", deleteData)
 		if err != nil {
-			return fmt.Errorf("error deleting team member: %v", err)
+			return diag.FromErr(fmt.Errorf("error deleting team member: %v", err))
 		}
 		defer resp.Body.Close()
 
 		if err := handleResponse(resp, "deleting team member"); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
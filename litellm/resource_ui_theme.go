@@ -0,0 +1,99 @@
+package litellm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	endpointUIThemeSettingsGet    = "/get/ui_theme_settings"
+	endpointUIThemeSettingsUpdate = "/update/ui_theme_settings"
+
+	uiThemeSettingsID = "ui_theme_settings"
+)
+
+var uiThemeSettingsFields = []string{
+	"logo_url",
+}
+
+func resourceLiteLLMUITheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiteLLMUIThemeCreate,
+		ReadContext:   resourceLiteLLMUIThemeRead,
+		UpdateContext: resourceLiteLLMUIThemeUpdate,
+		DeleteContext: resourceLiteLLMUIThemeDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			"logo_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "URL of the logo displayed in the admin UI",
+			},
+		},
+	}
+}
+
+func resourceLiteLLMUIThemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := writeUIThemeSettings(ctx, d, m); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating UI theme settings: %w", err))
+	}
+
+	d.SetId(uiThemeSettingsID)
+	log.Printf("[INFO] UI theme settings created")
+
+	return resourceLiteLLMUIThemeRead(ctx, d, m)
+}
+
+func resourceLiteLLMUIThemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	values, err := getSettings(client, endpointUIThemeSettingsGet)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading UI theme settings: %w", err))
+	}
+
+	return diag.FromErr(setSettingsFields(d, values, uiThemeSettingsFields))
+}
+
+func resourceLiteLLMUIThemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := writeUIThemeSettings(ctx, d, m); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating UI theme settings: %w", err))
+	}
+
+	log.Printf("[INFO] UI theme settings updated")
+	return resourceLiteLLMUIThemeRead(ctx, d, m)
+}
+
+func resourceLiteLLMUIThemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
+
+	payload := map[string]interface{}{
+		"logo_url": nil,
+	}
+
+	if err := updateSettings(client, endpointUIThemeSettingsUpdate, payload); err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting UI theme settings: %w", err))
+	}
+
+	log.Printf("[INFO] UI theme settings reset to defaults")
+	d.SetId("")
+	return nil
+}
+
+// writeUIThemeSettings performs a read-modify-write of the UI theme settings.
+func writeUIThemeSettings(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client).withContext(ctx)
+
+	current, err := getSettings(client, endpointUIThemeSettingsGet)
+	if err != nil {
+		return err
+	}
+
+	return updateSettings(client, endpointUIThemeSettingsUpdate, mergeSettings(d, current, uiThemeSettingsFields))
+}
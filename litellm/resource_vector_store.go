@@ -6,10 +6,15 @@ import (
 
 func resourceLiteLLMVectorStore() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLiteLLMVectorStoreCreate,
-		Read:   resourceLiteLLMVectorStoreRead,
-		Update: resourceLiteLLMVectorStoreUpdate,
-		Delete: resourceLiteLLMVectorStoreDelete,
+		CreateContext: resourceLiteLLMVectorStoreCreate,
+		ReadContext:   resourceLiteLLMVectorStoreRead,
+		UpdateContext: resourceLiteLLMVectorStoreUpdate,
+		DeleteContext: resourceLiteLLMVectorStoreDelete,
+		Timeouts:      defaultResourceTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vector_store_id": {
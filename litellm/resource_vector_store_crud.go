@@ -1,14 +1,16 @@
 package litellm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func resourceLiteLLMVectorStoreCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMVectorStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 
 	vectorStoreName := d.Get("vector_store_name").(string)
 	customLLMProvider := d.Get("custom_llm_provider").(string)
@@ -40,24 +42,24 @@ func resourceLiteLLMVectorStoreCreate(d *schema.ResourceData, m interface{}) err
 
 	resp, err := MakeRequest(client, "POST", "/vector_store/new", vectorStoreRequest)
 	if err != nil {
-		return fmt.Errorf("failed to create vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to create vector store: %w", err))
 	}
 	defer resp.Body.Close()
 
 	err = handleVectorStoreAPIResponse(resp, nil, client)
 	if err != nil {
-		return fmt.Errorf("failed to create vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to create vector store: %w", err))
 	}
 
 	// Set the resource ID to the vector store name for now
 	// We'll update this after reading the response to get the actual ID
 	d.SetId(vectorStoreName)
 
-	return resourceLiteLLMVectorStoreRead(d, m)
+	return resourceLiteLLMVectorStoreRead(ctx, d, m)
 }
 
-func resourceLiteLLMVectorStoreRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMVectorStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	vectorStoreID := d.Id()
 
 	// Use the info endpoint to get vector store details
@@ -67,7 +69,7 @@ func resourceLiteLLMVectorStoreRead(d *schema.ResourceData, m interface{}) error
 
 	resp, err := MakeRequest(client, "POST", "/vector_store/info", infoRequest)
 	if err != nil {
-		return fmt.Errorf("failed to read vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read vector store: %w", err))
 	}
 	defer resp.Body.Close()
 
@@ -83,7 +85,7 @@ func resourceLiteLLMVectorStoreRead(d *schema.ResourceData, m interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to read vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read vector store: %w", err))
 	}
 
 	// Update the resource ID to the actual vector store ID from the response
@@ -104,8 +106,8 @@ func resourceLiteLLMVectorStoreRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceLiteLLMVectorStoreUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMVectorStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	vectorStoreID := d.Id()
 
 	vectorStoreName := d.Get("vector_store_name").(string)
@@ -129,20 +131,20 @@ func resourceLiteLLMVectorStoreUpdate(d *schema.ResourceData, m interface{}) err
 
 	resp, err := MakeRequest(client, "POST", "/vector_store/update", vectorStoreRequest)
 	if err != nil {
-		return fmt.Errorf("failed to update vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update vector store: %w", err))
 	}
 	defer resp.Body.Close()
 
 	err = handleVectorStoreAPIResponse(resp, nil, client)
 	if err != nil {
-		return fmt.Errorf("failed to update vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update vector store: %w", err))
 	}
 
-	return resourceLiteLLMVectorStoreRead(d, m)
+	return resourceLiteLLMVectorStoreRead(ctx, d, m)
 }
 
-func resourceLiteLLMVectorStoreDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+func resourceLiteLLMVectorStoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client).withContext(ctx)
 	vectorStoreID := d.Id()
 
 	deleteRequest := VectorStoreDeleteRequest{
@@ -151,7 +153,7 @@ func resourceLiteLLMVectorStoreDelete(d *schema.ResourceData, m interface{}) err
 
 	resp, err := MakeRequest(client, "POST", "/vector_store/delete", deleteRequest)
 	if err != nil {
-		return fmt.Errorf("failed to delete vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to delete vector store: %w", err))
 	}
 	defer resp.Body.Close()
 
@@ -161,7 +163,7 @@ func resourceLiteLLMVectorStoreDelete(d *schema.ResourceData, m interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to delete vector store: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to delete vector store: %w", err))
 	}
 
 	d.SetId("")
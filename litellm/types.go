@@ -5,6 +5,22 @@ type ProviderConfig struct {
 	APIBase            string
 	APIKey             string
 	InsecureSkipVerify bool
+	AuthHeaderStyle    string
+	Headers            map[string]string
+	TokenCommand       string
+	TokenFile          string
+	ValidateModelNames bool
+	ReadCache          bool
+	Transport          TransportConfig
+	Limits             RequestLimitsConfig
+}
+
+// RequestLimitsConfig holds the concurrency and rate limits of requests to LiteLLM.
+type RequestLimitsConfig struct {
+	MaxConcurrentRequests         int
+	RequestsPerSecond             float64
+	EndpointMaxConcurrentRequests map[string]string
+	EndpointRequestsPerSecond     map[string]string
 }
 
 // ErrorResponse represents an error response from the API.
@@ -25,11 +41,31 @@ type ModelResponse struct {
 	Additional    map[string]interface{} `json:"additional"`
 }
 
+// ModelListResponse represents the list of deployed models returned by /model/info.
+type ModelListResponse struct {
+	Data []DeployedModel `json:"data"`
+}
+
+// DeployedModel represents a model deployment in a ModelListResponse. litellm_params
+// is kept untyped so that explicit zero values can be told apart from unset ones.
+type DeployedModel struct {
+	ModelName     string                 `json:"model_name"`
+	LiteLLMParams map[string]interface{} `json:"litellm_params,omitempty"`
+	ModelInfo     DeployedModelInfo      `json:"model_info"`
+}
+
+// DeployedModelInfo represents the model_info of a DeployedModel.
+type DeployedModelInfo struct {
+	ModelInfo
+	AccessGroups        []string `json:"access_groups,omitempty"`
+	TeamPublicModelName string   `json:"team_public_model_name,omitempty"`
+}
+
 // ModelRequest represents a request to create or update a model.
 type ModelRequest struct {
 	ModelName     string                 `json:"model_name"`
 	LiteLLMParams map[string]interface{} `json:"litellm_params"`
-	ModelInfo     ModelInfo              `json:"model_info"`
+	ModelInfo     map[string]interface{} `json:"model_info"`
 	Additional    map[string]interface{} `json:"additional"`
 }
 
@@ -46,6 +82,39 @@ type TeamResponse struct {
 	Models                []string               `json:"models"`
 	Blocked               bool                   `json:"blocked,omitempty"`
 	TeamMemberPermissions []string               `json:"team_member_permissions,omitempty"`
+	Guardrails            []string               `json:"guardrails,omitempty"`
+	Tags                  []string               `json:"tags,omitempty"`
+	MaxParallelRequests   int                    `json:"max_parallel_requests,omitempty"`
+	TeamMemberBudget      float64                `json:"team_member_budget,omitempty"`
+	TeamMemberKeyDuration string                 `json:"team_member_key_duration,omitempty"`
+	TeamMemberBudgetTable *TeamMemberBudgetTable `json:"team_member_budget_table,omitempty"`
+	ModelTable            *TeamModelTable        `json:"litellm_model_table,omitempty"`
+	ObjectPermission      *ObjectPermission      `json:"object_permission,omitempty"`
+}
+
+// TeamInfoResponse represents the /team/info response, which nests the team under team_info.
+type TeamInfoResponse struct {
+	TeamID   string        `json:"team_id"`
+	TeamInfo *TeamResponse `json:"team_info"`
+}
+
+// TeamMemberBudgetTable represents the budget applied to each member of a team.
+type TeamMemberBudgetTable struct {
+	MaxBudget float64 `json:"max_budget,omitempty"`
+}
+
+// TeamModelTable represents the model aliases of a team.
+type TeamModelTable struct {
+	ModelAliases map[string]interface{} `json:"model_aliases,omitempty"`
+}
+
+// ObjectPermission represents the MCP servers, tools and vector stores a team or key may use.
+type ObjectPermission struct {
+	ObjectPermissionID string              `json:"object_permission_id,omitempty"`
+	MCPServers         []string            `json:"mcp_servers"`
+	MCPAccessGroups    []string            `json:"mcp_access_groups"`
+	MCPToolPermissions map[string][]string `json:"mcp_tool_permissions"`
+	VectorStores       []string            `json:"vector_stores"`
 }
 
 // OrganizationResponse represents a response from the API containing organization information.
@@ -59,6 +128,12 @@ type OrganizationResponse struct {
 	TPMLimit          int                    `json:"tpm_limit,omitempty"`
 	RPMLimit          int                    `json:"rpm_limit,omitempty"`
 	Blocked           bool                   `json:"blocked,omitempty"`
+	BudgetTable       *OrganizationBudget    `json:"litellm_budget_table,omitempty"`
+}
+
+// OrganizationBudget represents the budget table attached to an organization.
+type OrganizationBudget struct {
+	ModelMaxBudget map[string]interface{} `json:"model_max_budget,omitempty"`
 }
 
 // LiteLLMParams represents the parameters for LiteLLM.
@@ -116,6 +191,7 @@ type Key struct {
 	SoftBudget           float64                `json:"soft_budget,omitempty"`
 	KeyAlias             string                 `json:"key_alias,omitempty"`
 	Duration             string                 `json:"duration,omitempty"`
+	Expires              string                 `json:"expires,omitempty"`
 	Aliases              map[string]interface{} `json:"aliases,omitempty"`
 	Config               map[string]interface{} `json:"config,omitempty"`
 	Permissions          map[string]interface{} `json:"permissions,omitempty"`
@@ -125,6 +201,7 @@ type Key struct {
 	Guardrails           []string               `json:"guardrails,omitempty"`
 	Blocked              bool                   `json:"blocked"`
 	Tags                 []string               `json:"tags,omitempty"`
+	ObjectPermission     *ObjectPermission      `json:"object_permission,omitempty"`
 }
 
 // KeyResponse represents a response from the API containing key information.
@@ -146,21 +223,29 @@ type MCPInfo struct {
 	MCPServerCostInfo *MCPServerCostInfo `json:"mcp_server_cost_info,omitempty"`
 }
 
-// MCPServerRequest represents a request to create or update an MCP server.
-type MCPServerRequest struct {
-	ServerID        string            `json:"server_id,omitempty"`
-	ServerName      string            `json:"server_name"`
-	Alias           string            `json:"alias,omitempty"`
-	Description     string            `json:"description,omitempty"`
-	Transport       string            `json:"transport"`
-	SpecVersion     string            `json:"spec_version,omitempty"`
-	AuthType        string            `json:"auth_type,omitempty"`
-	URL             string            `json:"url"`
-	MCPInfo         *MCPInfo          `json:"mcp_info,omitempty"`
-	MCPAccessGroups []string          `json:"mcp_access_groups,omitempty"`
-	Command         string            `json:"command,omitempty"`
-	Args            []string          `json:"args,omitempty"`
-	Env             map[string]string `json:"env,omitempty"`
+// MCPAccessGroupsResponse represents a response from the API listing MCP access groups.
+type MCPAccessGroupsResponse struct {
+	AccessGroups []string `json:"access_groups"`
+}
+
+// MCPToolsListResponse represents a response from the API listing MCP tools.
+type MCPToolsListResponse struct {
+	Tools   []MCPTool   `json:"tools"`
+	Error   interface{} `json:"error,omitempty"`
+	Message string      `json:"message,omitempty"`
+}
+
+// MCPTool represents a tool exposed by an MCP server.
+type MCPTool struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	InputSchema interface{}  `json:"inputSchema,omitempty"`
+	MCPInfo     *MCPToolInfo `json:"mcp_info,omitempty"`
+}
+
+// MCPToolInfo represents the MCP server information attached to a tool.
+type MCPToolInfo struct {
+	ServerName string `json:"server_name,omitempty"`
 }
 
 // MCPServerResponse represents a response from the API containing MCP server information.
@@ -186,6 +271,9 @@ type MCPServerResponse struct {
 	Command          string              `json:"command,omitempty"`
 	Args             []string            `json:"args,omitempty"`
 	Env              map[string]string   `json:"env,omitempty"`
+	TokenURL         string              `json:"token_url,omitempty"`
+	AuthorizationURL string              `json:"authorization_url,omitempty"`
+	ExtraHeaders     []string            `json:"extra_headers,omitempty"`
 }
 
 // CredentialRequest represents a request to create or update a credential.
@@ -245,3 +333,87 @@ type VectorStoreDeleteRequest struct {
 type VectorStoreInfoRequest struct {
 	VectorStoreID string `json:"vector_store_id"`
 }
+
+// SettingsResponse represents a response from the API containing proxy-wide settings.
+type SettingsResponse struct {
+	Values      map[string]interface{} `json:"values"`
+	FieldSchema map[string]interface{} `json:"field_schema"`
+}
+
+// EmailEventSetting represents whether emails are sent for a single lifecycle event.
+type EmailEventSetting struct {
+	Event   string `json:"event"`
+	Enabled bool   `json:"enabled"`
+}
+
+// EmailEventSettingsRequest represents a request to update email event settings.
+type EmailEventSettingsRequest struct {
+	Settings []EmailEventSetting `json:"settings"`
+}
+
+// EmailEventSettingsResponse represents a response from the API containing email event settings.
+type EmailEventSettingsResponse struct {
+	Settings []EmailEventSetting `json:"settings"`
+}
+
+// SCIM schema URNs used in SCIM 2.0 payloads.
+const (
+	scimSchemaUser    = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup   = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaPatchOp = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// SCIMName represents the name components of a SCIM user.
+type SCIMName struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	Formatted  string `json:"formatted,omitempty"`
+}
+
+// SCIMEmail represents an email address of a SCIM user.
+type SCIMEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// SCIMMember represents a reference from a SCIM group to a user, or from a user to a group.
+type SCIMMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// SCIMUser represents a SCIM 2.0 user.
+type SCIMUser struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName,omitempty"`
+	Name        *SCIMName    `json:"name,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Active      bool         `json:"active"`
+	Emails      []SCIMEmail  `json:"emails,omitempty"`
+	Groups      []SCIMMember `json:"groups,omitempty"`
+}
+
+// SCIMGroup represents a SCIM 2.0 group, which LiteLLM maps to a team.
+type SCIMGroup struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []SCIMMember `json:"members,omitempty"`
+}
+
+// SCIMPatchOperation represents a single operation of a SCIM PATCH request.
+type SCIMPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// SCIMPatchOp represents a SCIM 2.0 PATCH request.
+type SCIMPatchOp struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []SCIMPatchOperation `json:"Operations"`
+}
@@ -70,9 +70,9 @@ func MakeRequest(client *Client, method, endpoint string, body interface{}) (*ht
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		req, err = http.NewRequest(method, fmt.Sprintf("%s%s", client.APIBase, endpoint), bytes.NewBuffer(jsonData))
+		req, err = http.NewRequestWithContext(client.context(), method, fmt.Sprintf("%s%s", client.APIBase, endpoint), bytes.NewBuffer(jsonData))
 	} else {
-		req, err = http.NewRequest(method, fmt.Sprintf("%s%s", client.APIBase, endpoint), nil)
+		req, err = http.NewRequestWithContext(client.context(), method, fmt.Sprintf("%s%s", client.APIBase, endpoint), nil)
 	}
 
 	if err != nil {
@@ -80,9 +80,8 @@ func MakeRequest(client *Client, method, endpoint string, body interface{}) (*ht
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", client.APIKey)
 
-	return client.httpClient.Do(req)
+	return client.do(req)
 }
 
 // Helper functions to handle potential nil values from the API response
@@ -277,3 +276,28 @@ func handleVectorStoreAPIResponse(resp *http.Response, result interface{}, clien
 
 	return nil
 }
+
+// handleSCIMAPIResponse handles API responses specifically for SCIM operations
+func handleSCIMAPIResponse(resp *http.Response, result interface{}, client *Client) error {
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("scim_resource_not_found")
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("API request failed: Status: %s, Response: %s",
+			resp.Status, client.redactSensitiveData(string(bodyBytes)))
+	}
+
+	if result != nil && len(bodyBytes) > 0 {
+		if err := json.Unmarshal(bodyBytes, result); err != nil {
+			return fmt.Errorf("failed to parse response: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -1,14 +1,51 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/nicholas-cecere/terraform-provider-litellm/litellm"
 )
 
-// main is the entry point for the plugin. It serves the provider
-// using the Terraform plugin SDK.
+// subcommands write configuration for existing proxies instead of serving the provider.
+var subcommands = map[string]func(ctx context.Context, args []string) error{
+	"export":         litellm.Export,
+	"convert-config": litellm.ConvertConfig,
+}
+
+// main is the entry point for the plugin. It serves the SDK provider and the
+// resources migrated to terraform-plugin-framework behind a protocol 6 mux server.
+// The export and convert-config subcommands write configuration for existing proxies.
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: litellm.Provider,
-	})
+	ctx := context.Background()
+
+	if len(os.Args) > 1 {
+		if subcommand, ok := subcommands[os.Args[1]]; ok {
+			if err := subcommand(ctx, os.Args[2:]); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+	}
+
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	providerServer, err := litellm.ProviderServer(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	if err := tf6server.Serve("registry.terraform.io/ncecere/litellm", providerServer, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }